@@ -3,6 +3,9 @@ package main
 import (
 	"flag"
 	"io/ioutil"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/apesternikov/backplane/src/requestlog"
 
@@ -37,9 +40,20 @@ func main() {
 	if err != nil {
 		glog.Fatalf("Unable to create backplane: %s", err)
 	}
+	go leaveOnSignal(b)
 
 	glog.Fatal(http.ListenAndServe(*debuglisten, nil))
 	// wait forever
 	// var done chan struct{}
 	// <-done
 }
+
+// leave the cluster on shutdown so other members do not wait for failure detection
+func leaveOnSignal(b *backplane.Backplane) {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	glog.Infof("Received %s, shutting down", <-sig)
+	b.Leave()
+	glog.Flush()
+	os.Exit(0)
+}
@@ -0,0 +1,30 @@
+package backplane
+
+import (
+	"net/http"
+
+	"github.com/apesternikov/backplane/src/config"
+	"github.com/apesternikov/backplane/src/ipacl"
+
+	"github.com/golang/glog"
+)
+
+func newACL(cf *config.IpAcl) (*ipacl.ACL, error) {
+	return ipacl.New(cf.Allow, cf.Deny, cf.AllowFile, cf.DenyFile)
+}
+
+// aclWrapper rejects requests from client ips denied by the ACL
+type aclWrapper struct {
+	ACL     *ipacl.ACL
+	Handler http.Handler
+}
+
+func (a *aclWrapper) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if ip := requestClientIp(r); !a.ACL.Allowed(ip) {
+		glog.V(2).Infof("acl rejected %s %s from %s", r.Method, r.RequestURI, ip)
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("403 Forbidden\n"))
+		return
+	}
+	a.Handler.ServeHTTP(w, r)
+}
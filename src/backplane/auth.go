@@ -3,23 +3,81 @@ package backplane
 import (
 	"errors"
 	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/apesternikov/backplane/src/context"
+	"github.com/apesternikov/backplane/src/htpasswd"
 
 	"github.com/apesternikov/backplane/src/config"
 )
 
+const defaultUserHeader = "X-Authenticated-User"
+
 type basicAuthWrapper struct {
-	Config  *config.AuthHttpBasicT
-	Handler http.Handler
+	Config     *config.AuthHttpBasicT
+	Handler    http.Handler
+	htpasswd   *htpasswd.File
+	failures   *loginFailures
+	userHeader string
+}
+
+func newBasicAuthWrapper(cf *config.AuthHttpBasicT, h http.Handler) (*basicAuthWrapper, error) {
+	b := &basicAuthWrapper{Config: cf, Handler: h, userHeader: cf.UserHeader}
+	if b.userHeader == "" {
+		b.userHeader = defaultUserHeader
+	}
+	if cf.HtpasswdFile != "" {
+		var err error
+		b.htpasswd, err = htpasswd.Open(cf.HtpasswdFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cf.MaxFailures > 0 {
+		lockout := time.Duration(cf.LockoutSeconds) * time.Second
+		if lockout == 0 {
+			lockout = 5 * time.Minute
+		}
+		b.failures = newLoginFailures(cf.MaxFailures, lockout)
+	}
+	return b, nil
+}
+
+// verify password in constant time, regardless of the user existence
+func (b *basicAuthWrapper) verify(username, password string) bool {
+	if value, ok := b.Config.Userpass[username]; ok {
+		return htpasswd.VerifyInline(value, password)
+	}
+	if b.htpasswd != nil {
+		if hash, ok := b.htpasswd.Lookup(username); ok {
+			return htpasswd.Verify(hash, password)
+		}
+	}
+	return htpasswd.VerifyMissing(password)
 }
 
 func (b *basicAuthWrapper) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	username, password, ok := r.BasicAuth()
 	if ok {
-		storedpass, ok := b.Config.Userpass[username]
-		if ok && storedpass == password {
+		key := username + "@" + requestClientIp(r)
+		if wait := b.failures.lockedOut(key); wait > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(wait/time.Second)+1))
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte("429 Too Many Failed Logins\n"))
+			return
+		}
+		if b.verify(username, password) {
+			b.failures.succeeded(key)
+			if ctx := context.GetRequestContext(r); ctx != nil {
+				ctx.Log.User = username
+			}
+			r.Header.Set(b.userHeader, username)
 			b.Handler.ServeHTTP(w, r)
 			return
 		}
+		b.failures.failed(key)
 	}
 	w.Header().Set("WWW-Authenticate", `Basic realm="`+b.Config.Realm+`"`)
 	w.WriteHeader(401)
@@ -27,10 +85,97 @@ func (b *basicAuthWrapper) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	return
 }
 
-func AuthWrapper(cf *config.Auth, h http.Handler) (http.Handler, error) {
+// loginFailures counts failed logins per user/client ip and locks the pair out
+// after too many failures. nil *loginFailures never locks out.
+type loginFailures struct {
+	max       int64
+	lockout   time.Duration
+	mu        sync.Mutex
+	entries   map[string]*loginFailure
+	lastPurge time.Time
+}
+
+type loginFailure struct {
+	count       int64
+	last        time.Time
+	lockedUntil time.Time
+}
+
+func newLoginFailures(max int64, lockout time.Duration) *loginFailures {
+	return &loginFailures{
+		max:       max,
+		lockout:   lockout,
+		entries:   make(map[string]*loginFailure),
+		lastPurge: time.Now(),
+	}
+}
+
+// lockedOut returns remaining lockout time for the key or 0 if not locked
+func (l *loginFailures) lockedOut(key string) time.Duration {
+	if l == nil {
+		return 0
+	}
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if e, ok := l.entries[key]; ok && now.Before(e.lockedUntil) {
+		return e.lockedUntil.Sub(now)
+	}
+	return 0
+}
+
+func (l *loginFailures) failed(key string) {
+	if l == nil {
+		return
+	}
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.purge(now)
+	e, ok := l.entries[key]
+	if !ok || now.Sub(e.last) > l.lockout {
+		e = &loginFailure{}
+		l.entries[key] = e
+	}
+	e.count++
+	e.last = now
+	if e.count >= l.max {
+		e.count = 0
+		e.lockedUntil = now.Add(l.lockout)
+	}
+}
+
+func (l *loginFailures) succeeded(key string) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	delete(l.entries, key)
+	l.mu.Unlock()
+}
+
+// drop stale entries once in a while to keep memory bounded. assumes l is locked
+func (l *loginFailures) purge(now time.Time) {
+	if now.Sub(l.lastPurge) < time.Minute {
+		return
+	}
+	l.lastPurge = now
+	for key, e := range l.entries {
+		if now.Sub(e.last) > l.lockout && now.After(e.lockedUntil) {
+			delete(l.entries, key)
+		}
+	}
+}
+
+// AuthWrapper wraps h with the configured authentication. backends resolves backend names used by forward auth
+func AuthWrapper(cf *config.Auth, h http.Handler, backends HandlersMap) (http.Handler, error) {
 	switch {
 	case cf.HttpBasic != nil:
-		return &basicAuthWrapper{Config: cf.HttpBasic, Handler: h}, nil
+		return newBasicAuthWrapper(cf.HttpBasic, h)
+	case cf.Jwt != nil:
+		return newJwtAuthWrapper(cf.Jwt, h)
+	case cf.Forward != nil:
+		return newForwardAuthWrapper(cf.Forward, h, backends)
 	default:
 		return nil, errors.New("Auth config error")
 	}
@@ -0,0 +1,318 @@
+package backplane
+
+import (
+	stdcontext "context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apesternikov/backplane/src/config"
+	"github.com/apesternikov/backplane/src/context"
+	"github.com/apesternikov/backplane/src/requestlog"
+)
+
+func basicAuthRequest(t *testing.T, h http.Handler, user, pass string) (*httptest.ResponseRecorder, *requestlog.Item) {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "http://one.com/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	req.Header.Set("X-Authenticated-User", "spoofed")
+	if user != "" {
+		req.SetBasicAuth(user, pass)
+	}
+	log := &requestlog.Item{ClientIp: "10.0.0.1"}
+	context.NewRequestContext(req, &context.RequestContext{Log: log})
+	defer context.Clear(req)
+	h.ServeHTTP(w, req)
+	return w, log
+}
+
+func TestBasicAuth(t *testing.T) {
+	htfile, err := ioutil.TempFile("", "htpasswd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(htfile.Name())
+	htfile.WriteString("fileuser:$5$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5\n")
+	htfile.Close()
+
+	var upstreamUser string
+	backend := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstreamUser = r.Header.Get("X-Authenticated-User")
+	})
+	h, err := AuthWrapper(&config.Auth{HttpBasic: &config.AuthHttpBasicT{
+		Realm: "test",
+		Userpass: map[string]string{
+			"plain":  "secret",
+			"bcrypt": "$2a$04$swjGWHdDYRXLdhka5XCENOCfmK4h2seta2sUSP/Q7tbFicKOBbBlS",
+		},
+		HtpasswdFile: htfile.Name(),
+		MaxFailures:  3,
+	}}, backend, nil)
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+
+	testcases := []struct {
+		user, pass string
+		code       int
+	}{
+		{"", "", 401},
+		{"plain", "secret", 200},
+		{"plain", "wrong", 401},
+		{"bcrypt", "secret", 200},
+		{"bcrypt", "wrong", 401},
+		{"fileuser", "Hello world!", 200},
+		{"nosuchuser", "secret", 401},
+	}
+	for i, tc := range testcases {
+		upstreamUser = ""
+		w, log := basicAuthRequest(t, h, tc.user, tc.pass)
+		if w.Code != tc.code {
+			t.Errorf("testcase %d: expected status %d got %d", i+1, tc.code, w.Code)
+		}
+		if tc.code == 200 && (upstreamUser != tc.user || log.User != tc.user) {
+			t.Errorf("testcase %d: expected user %s upstream and in log, got %q %q", i+1, tc.user, upstreamUser, log.User)
+		}
+	}
+
+	// two more failures lock the user out from this ip even with the right password
+	basicAuthRequest(t, h, "plain", "wrong")
+	basicAuthRequest(t, h, "plain", "wrong")
+	w, _ := basicAuthRequest(t, h, "plain", "secret")
+	if w.Code != http.StatusTooManyRequests || w.Header().Get("Retry-After") == "" {
+		t.Errorf("expected lockout, got %d", w.Code)
+	}
+	// other users are not affected
+	w, _ = basicAuthRequest(t, h, "bcrypt", "secret")
+	if w.Code != 200 {
+		t.Errorf("expected 200 for other user, got %d", w.Code)
+	}
+}
+
+func hs256(t *testing.T, secret string, claims string) string {
+	signed := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestJwtAuth(t *testing.T) {
+	var upstream http.Header
+	backend := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstream = r.Header
+	})
+	h, err := AuthWrapper(&config.Auth{Jwt: &config.AuthJwtT{
+		HmacSecret:   []string{"secret"},
+		Issuer:       []string{"issuer"},
+		RequireClaim: map[string]string{"roles": "admin"},
+		ForwardClaim: map[string]string{"sub": "X-User", "org.id": "X-Org"},
+		Realm:        "api",
+	}}, backend, nil)
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	testcases := []struct {
+		authz string
+		code  int
+	}{
+		{"", 401},
+		{"Basic Zm9vOmJhcg==", 401},
+		{"Bearer garbage", 401},
+		{"Bearer " + hs256(t, "wrong", `{"iss":"issuer","sub":"joe","roles":["admin"]}`), 401},
+		{"Bearer " + hs256(t, "secret", `{"iss":"other","sub":"joe","roles":["admin"]}`), 401},
+		{"Bearer " + hs256(t, "secret", `{"iss":"issuer","sub":"joe","roles":["admin"],"exp":1}`), 401},
+		{"Bearer " + hs256(t, "secret", `{"iss":"issuer","sub":"joe","roles":["admin"]}`), 401},
+		{"Bearer " + hs256(t, "secret", `{"iss":"issuer","sub":"joe","roles":["user"],"exp":4102444800}`), 403},
+		{"bearer " + hs256(t, "secret", `{"iss":"issuer","sub":"joe","roles":["user","admin"],"org":{"id":7},"exp":4102444800}`), 200},
+	}
+	for i, tc := range testcases {
+		upstream = nil
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "http://one.com/", nil)
+		req.Header.Set("X-User", "spoofed")
+		req.Header.Set("X-Org", "spoofed")
+		if tc.authz != "" {
+			req.Header.Set("Authorization", tc.authz)
+		}
+		log := &requestlog.Item{}
+		context.NewRequestContext(req, &context.RequestContext{Log: log})
+		h.ServeHTTP(w, req)
+		context.Clear(req)
+		if w.Code != tc.code {
+			t.Errorf("testcase %d: expected status %d got %d", i+1, tc.code, w.Code)
+		}
+		if tc.code == 401 && !strings.HasPrefix(w.Header().Get("WWW-Authenticate"), `Bearer realm="api"`) {
+			t.Errorf("testcase %d: unexpected challenge %q", i+1, w.Header().Get("WWW-Authenticate"))
+		}
+		if tc.code == 200 {
+			if upstream.Get("X-User") != "joe" || upstream.Get("X-Org") != "7" || log.User != "joe" {
+				t.Errorf("testcase %d: unexpected upstream headers %v, log user %q", i+1, upstream, log.User)
+			}
+		}
+	}
+}
+
+func TestForwardAuth(t *testing.T) {
+	authCalls := 0
+	authService := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authCalls++
+		if r.Header.Get("X-Extra") != "" {
+			t.Error("non whitelisted header passed to the auth service")
+		}
+		if r.Method != "POST" || r.URL.Path != "/app" || r.Host != "one.com" {
+			t.Errorf("unexpected subrequest %s %s %s", r.Method, r.Host, r.URL)
+		}
+		switch r.Header.Get("Authorization") {
+		case "good":
+			w.Header().Set("X-Auth-User", "joe")
+			w.Header().Set("X-Internal", "secret")
+		case "broken":
+			w.WriteHeader(500)
+		default:
+			w.Header().Set("Location", "https://sso/login")
+			w.WriteHeader(401)
+			w.Write([]byte("login please"))
+		}
+	})
+	var upstream http.Header
+	backend := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstream = r.Header
+	})
+	backends := func(name string) http.Handler {
+		if name == "auth" {
+			return authService
+		}
+		return nil
+	}
+	if _, err := AuthWrapper(&config.Auth{Forward: &config.AuthForwardT{BackendName: "nosuch"}}, backend, backends); err == nil {
+		t.Error("expected error for unknown backend")
+	}
+	h, err := AuthWrapper(&config.Auth{Forward: &config.AuthForwardT{
+		BackendName:    "auth",
+		ResponseHeader: []string{"X-Auth-User"},
+		CacheSeconds:   60,
+	}}, backend, backends)
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	do := func(authz string) *httptest.ResponseRecorder {
+		upstream = nil
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "http://one.com/app", strings.NewReader("body"))
+		req.Header.Set("X-Auth-User", "spoofed")
+		req.Header.Set("X-Extra", "x")
+		if authz != "" {
+			req.Header.Set("Authorization", authz)
+		}
+		context.NewRequestContext(req, &context.RequestContext{Log: &requestlog.Item{}})
+		defer context.Clear(req)
+		h.ServeHTTP(w, req)
+		return w
+	}
+
+	w := do("good")
+	if w.Code != 200 || upstream.Get("X-Auth-User") != "joe" || upstream.Get("X-Internal") != "" {
+		t.Errorf("unexpected result %d, upstream headers %v", w.Code, upstream)
+	}
+	w = do("bad")
+	if w.Code != 401 || w.Body.String() != "login please" || w.Header().Get("Location") != "https://sso/login" || upstream != nil {
+		t.Errorf("expected auth service response to be passed as is, got %d %q %v", w.Code, w.Body.String(), w.Header())
+	}
+	// cached results
+	do("good")
+	do("bad")
+	if authCalls != 2 {
+		t.Errorf("expected 2 auth calls got %d", authCalls)
+	}
+	// failures and requests without the cache key are not cached
+	do("broken")
+	do("broken")
+	w = do("")
+	if w.Code != 401 {
+		t.Errorf("expected 401 got %d", w.Code)
+	}
+	do("")
+	if authCalls != 6 {
+		t.Errorf("expected 6 auth calls got %d", authCalls)
+	}
+}
+
+func TestForwardAuthCancel(t *testing.T) {
+	cancelled := make(chan bool, 1)
+	authService := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			cancelled <- true
+			w.WriteHeader(http.StatusBadGateway)
+		case <-time.After(time.Second):
+			cancelled <- false
+		}
+	})
+	backend := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request passed upstream after cancel")
+	})
+	h, err := AuthWrapper(&config.Auth{Forward: &config.AuthForwardT{BackendName: "auth", CacheSeconds: 60}}, backend,
+		func(name string) http.Handler { return authService })
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	ctx, cancel := stdcontext.WithCancel(stdcontext.Background())
+	req, _ := http.NewRequest("GET", "http://one.com/app", nil)
+	req = req.WithContext(ctx)
+	req.Header.Set("Authorization", "good")
+	context.NewRequestContext(req, &context.RequestContext{Log: &requestlog.Item{}})
+	defer context.Clear(req)
+	cancel()
+	h.ServeHTTP(httptest.NewRecorder(), req)
+	if !<-cancelled {
+		t.Error("expected the subrequest to be cancelled with the request")
+	}
+	if fa := h.(*forwardAuthWrapper); fa.cache.get(fa.cacheKey(req)) != nil {
+		t.Error("cancelled check should not be cached")
+	}
+}
+
+func TestForwardAuthCacheKey(t *testing.T) {
+	authCalls := 0
+	authService := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authCalls++
+		if r.URL.Path != "/public" {
+			w.WriteHeader(403)
+		}
+	})
+	h, err := AuthWrapper(&config.Auth{Forward: &config.AuthForwardT{BackendName: "auth", CacheSeconds: 60}},
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+		func(name string) http.Handler { return authService })
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	do := func(uri string) int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "http://one.com"+uri, nil)
+		req.RequestURI = uri
+		req.Header.Set("Authorization", "token")
+		context.NewRequestContext(req, &context.RequestContext{Log: &requestlog.Item{}})
+		defer context.Clear(req)
+		h.ServeHTTP(w, req)
+		return w.Code
+	}
+	for i := 0; i < 2; i++ {
+		if code := do("/public"); code != 200 {
+			t.Errorf("expected /public to be allowed, got %d", code)
+		}
+		if code := do("/private"); code != 403 {
+			t.Errorf("expected /private to be denied, got %d", code)
+		}
+	}
+	if authCalls != 2 {
+		t.Errorf("expected 2 auth calls got %d", authCalls)
+	}
+}
@@ -1,6 +1,8 @@
 package backplane
 
 import (
+	stdcontext "context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
@@ -13,17 +15,23 @@ import (
 
 	"github.com/apesternikov/backplane/src/backplane/stats"
 	"github.com/apesternikov/backplane/src/config"
+	"github.com/apesternikov/backplane/src/proxyproto"
 	"github.com/golang/glog"
+	"golang.org/x/net/http2"
 	"golang.org/x/net/trace"
 )
 
 // transport used by backends. test could set a mock implementation
-var transportForBackend func(backendaddr string) http.RoundTripper = func(backendaddr string) http.RoundTripper {
+var transportForBackend func(cf *config.HttpBackend, backendaddr string) http.RoundTripper = func(cf *config.HttpBackend, backendaddr string) http.RoundTripper {
 	dialer := &net.Dialer{
 		Timeout:   3 * time.Second,
 		KeepAlive: 30 * time.Second,
 	}
-	return &http.Transport{
+	switch cf.Protocol {
+	case "h2c", "h2":
+		return newHttp2Transport(cf, backendaddr, dialer)
+	}
+	t := &http.Transport{
 		Dial: func(network, addr string) (net.Conn, error) {
 			//ignore address, always connect to the configured backend host
 			return dialer.Dial("tcp", backendaddr)
@@ -31,6 +39,46 @@ var transportForBackend func(backendaddr string) http.RoundTripper = func(backen
 		TLSHandshakeTimeout:   10 * time.Second, //we are not using TLS here, but keep it to avoid surprises later
 		ResponseHeaderTimeout: 30 * time.Second, //backend server timeout. TODO: make configurable
 	}
+	if cf.SendProxyProtocol != 0 {
+		// PROXY header describes the client, so connections can not be shared between clients
+		t.Dial = nil
+		t.DialContext = (&proxyproto.Dialer{Dialer: dialer, Version: int(cf.SendProxyProtocol), Addr: backendaddr}).DialContext
+		t.DisableKeepAlives = true
+	}
+	return t
+}
+
+// newHttp2Transport speaks HTTP/2 to the server, with prior knowledge (h2c) or negotiated over TLS (h2).
+// All requests to the server are multiplexed over a single connection.
+func newHttp2Transport(cf *config.HttpBackend, backendaddr string, dialer *net.Dialer) http.RoundTripper {
+	host, _, _ := net.SplitHostPort(backendaddr)
+	tlsconf := &tls.Config{
+		ServerName:         host,
+		NextProtos:         []string{http2.NextProtoTLS},
+		InsecureSkipVerify: cf.TlsSkipVerify,
+	}
+	return &http2.Transport{
+		// requests always have http scheme, TLS is up to the dialer
+		AllowHTTP: true,
+		DialTLSContext: func(ctx stdcontext.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+			//ignore address, always connect to the configured backend host
+			conn, err := dialer.DialContext(ctx, "tcp", backendaddr)
+			if err != nil || cf.Protocol == "h2c" {
+				return conn, err
+			}
+			tconn := tls.Client(conn, tlsconf)
+			if err := tconn.HandshakeContext(ctx); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			if p := tconn.ConnectionState().NegotiatedProtocol; p != http2.NextProtoTLS {
+				tconn.Close()
+				return nil, fmt.Errorf("server %s negotiated protocol %q instead of h2", backendaddr, p)
+			}
+			return tconn, nil
+		},
+		ReadIdleTimeout: 30 * time.Second, // ping the server to detect dead connections
+	}
 }
 
 type Backend struct {
@@ -40,7 +88,22 @@ type Backend struct {
 	stats.Counting
 	RateLimiter stats.RateLimiter
 	Limiter     stats.Limiter
-	Servers     []*Server
+	Servers     []*Server        // configured servers
+	Upgrades    *UpgradeCounters // WebSocket and other upgraded connections
+
+	mu            sync.Mutex
+	discovered    []*Server // servers of cluster members, see DiscoverServers
+	stopDiscovery func()
+}
+
+// GetServers returns configured and discovered servers
+func (b *Backend) GetServers() []*Server {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.discovered) == 0 {
+		return b.Servers
+	}
+	return append(append([]*Server(nil), b.Servers...), b.discovered...)
 }
 
 func (b *Backend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -71,12 +134,18 @@ func NewBackend(cf *config.HttpBackend) (*Backend, error) {
 			req.URL.Scheme = "http"
 			req.URL.Host = req.Host
 		},
-		Transport: balancer,
+		Transport:          balancer,
+		UpgradeIdleTimeout: time.Duration(cf.UpgradeIdleTimeoutMs) * time.Millisecond,
+		Upgrades:           &UpgradeCounters{},
+	}
+	rl, err := newRateLimiter("backend "+cf.Name, cf.ClusterRate, cf.RateAlgorithm, cf.Maxrate, cf.RateBurst)
+	if err != nil {
+		return nil, fmt.Errorf("backend %s: %s", cf.Name, err)
 	}
 	ch := &stats.CountersCollectingHandler{
 		Handler:     proxy,
-		RateLimiter: stats.NewRateLimiter(cf.Maxrate),
-		Limiter:     stats.NewLimiter(int(cf.Maxconn)),
+		RateLimiter: rl,
+		Limiter:     stats.NewQueueLimiter(int(cf.Maxconn), int(cf.Maxqueue), time.Duration(cf.QueueTimeoutMs)*time.Millisecond),
 	}
 	b := &Backend{
 		Cf:          cf,
@@ -86,12 +155,20 @@ func NewBackend(cf *config.HttpBackend) (*Backend, error) {
 		RateLimiter: ch.RateLimiter,
 		Limiter:     ch.Limiter,
 		Servers:     servers,
+		Upgrades:    proxy.Upgrades,
 	}
 	return b, nil
 }
 
 func (b *Backend) Stop() {
 	//TODO: drain and close all transports
+	b.mu.Lock()
+	stop := b.stopDiscovery
+	b.stopDiscovery = nil
+	b.mu.Unlock()
+	if stop != nil {
+		stop()
+	}
 }
 
 // Balancer implements http.RoundTripper and routes requests to configured backend servers
@@ -104,8 +181,11 @@ type Balancer struct {
 }
 
 func (b *Balancer) rebuildActive() {
-	activeHandlers := make([]*Server, 0, len(b.handlers))
-	for _, handler := range b.handlers {
+	b.mux.Lock()
+	handlers := b.handlers
+	b.mux.Unlock()
+	activeHandlers := make([]*Server, 0, len(handlers))
+	for _, handler := range handlers {
 		if handler.IsHealthy() {
 			activeHandlers = append(activeHandlers, handler)
 		}
@@ -115,6 +195,14 @@ func (b *Balancer) rebuildActive() {
 	b.mux.Unlock()
 }
 
+// replace servers of the balancer, used with servers discovered in the cluster
+func (b *Balancer) setHandlers(handlers []*Server) {
+	b.mux.Lock()
+	b.handlers = handlers
+	b.mux.Unlock()
+	b.rebuildActive()
+}
+
 var NoHealthyBackendAvailable = errors.New("No healthy backend server available")
 
 func (b *Balancer) RoundTrip(r *http.Request) (*http.Response, error) {
@@ -124,9 +212,9 @@ func (b *Balancer) RoundTrip(r *http.Request) (*http.Response, error) {
 	tr.LazyPrintf("balancer")
 	defer tr.LazyPrintf("balancer done")
 	idx := atomic.AddInt64(&b.idx, 1)
-	glog.V(3).Infof("Balancer serving %v using %d", r.URL, idx%int64(len(b.handlers)))
 	glog.V(3).Infof("Request %v", r)
 	b.mux.Lock()
+	glog.V(3).Infof("Balancer serving %v using %d of %d", r.URL, idx, len(b.activeHandlers))
 	if len(b.activeHandlers) == 0 {
 		b.mux.Unlock()
 		tr.LazyPrintf("No healthy backend server available")
@@ -146,7 +234,7 @@ func NewBalancer(cf *config.HttpBackend) (b *Balancer, servers []*Server) {
 	b = &Balancer{cf: cf}
 	servers = make([]*Server, 0, len(cf.Server))
 	for _, scf := range cf.Server {
-		s := NewServer(cf.Name, scf, b.rebuildActive)
+		s := NewServer(cf, scf, b.rebuildActive)
 		b.handlers = append(b.handlers, s)
 		servers = append(servers, s)
 	}
@@ -162,20 +250,62 @@ type Server struct {
 	RateLimiter stats.RateLimiter
 	Limiter     stats.Limiter
 	HealthChecker
+	transport http.RoundTripper
+	rateName  string // name of the cluster-wide rate limiter
 }
 
-func NewServer(backendName string, cf *config.Server, onStateUpdate func()) *Server {
-	t := transportForBackend(cf.Address)
+// Stop health checks, close idle connections and stop sharing the cluster rate of the server removed from the backend
+func (s *Server) Stop() {
+	if h, ok := s.HealthChecker.(*HttpHealthChecker); ok {
+		h.Stop()
+	}
+	if t, ok := s.transport.(interface {
+		CloseIdleConnections()
+	}); ok {
+		t.CloseIdleConnections()
+	}
+	releaseRateLimiter(s.rateName, s.RateLimiter)
+}
+
+func NewServer(backend *config.HttpBackend, cf *config.Server, onStateUpdate func()) *Server {
+	backendName := backend.Name
+	t := transportForBackend(backend, cf.Address)
+	rateName := "backend " + backendName + " server " + cf.Address
+	rl, err := newRateLimiter(rateName, cf.ClusterRate, cf.RateAlgorithm, cf.Maxrate, cf.RateBurst)
+	if err != nil {
+		// config is validated on load, should never happen
+		glog.Errorf("server %s: %s, using default rate limiter", cf.Address, err)
+		rl = stats.NewRateLimiter(cf.Maxrate)
+	}
+	limiter := stats.NewLimiter(int(cf.Maxconn))
+	if a := backend.ServerAdaptiveLimit; a != nil {
+		adaptive, err := stats.NewAdaptiveLimiter(stats.AdaptiveLimiterConfig{
+			Algorithm:    a.Algorithm,
+			MinLimit:     int(a.MinLimit),
+			MaxLimit:     int(a.MaxLimit),
+			InitialLimit: int(a.InitialLimit),
+			BackoffRatio: a.BackoffRatio,
+			MaxQueue:     int(a.Maxqueue),
+			QueueTimeout: time.Duration(a.QueueTimeoutMs) * time.Millisecond,
+		})
+		if err != nil {
+			// config is validated on load, should never happen
+			glog.Errorf("server %s: %s, using maxconn", cf.Address, err)
+		} else {
+			limiter = adaptive
+		}
+	}
 	ct := &stats.CountersCollectingRoundTripper{
 		RoundTripper: t,
-		RateLimiter:  stats.NewRateLimiter(cf.Maxrate),
-		Limiter:      stats.NewLimiter(int(cf.Maxconn)),
+		RateLimiter:  rl,
+		Limiter:      limiter,
 		TraceFamily:  "server." + backendName + "." + cf.Address,
 	}
-	//TODO: insert limiters here
 	//TODO: make prober url configurable
 	proberUrl := fmt.Sprintf("http://%s/", cf.Address)
 	prober := &HttpHealthChecker{Transport: t, Url: proberUrl, onStateUpdate: onStateUpdate}
+	// gRPC servers answer plain requests with 4xx
+	prober.AcceptClientErrors = backend.Protocol == "h2c" || backend.Protocol == "h2"
 	prober.Run()
 	return &Server{
 		Cf:            cf,
@@ -184,6 +314,8 @@ func NewServer(backendName string, cf *config.Server, onStateUpdate func()) *Ser
 		RateLimiter:   ct.RateLimiter,
 		Limiter:       ct.Limiter,
 		HealthChecker: prober,
+		transport:     t,
+		rateName:      rateName,
 	}
 }
 
@@ -194,15 +326,17 @@ type HealthChecker interface {
 }
 
 type HttpHealthChecker struct {
-	Transport     http.RoundTripper
-	Url           string
-	ticker        *time.Ticker
-	client        *http.Client
-	mux           sync.Mutex
-	isHealthy     bool
-	status        string
-	lastChange    time.Time
-	onStateUpdate func() // called when server status changed with mutex UNlocked
+	Transport          http.RoundTripper
+	Url                string
+	AcceptClientErrors bool // 4xx responses mean the server is up
+	ticker             *time.Ticker
+	done               chan struct{}
+	client             *http.Client
+	mux                sync.Mutex
+	isHealthy          bool
+	status             string
+	lastChange         time.Time
+	onStateUpdate      func() // called when server status changed with mutex UNlocked
 }
 
 func (h *HttpHealthChecker) IsHealthy() bool {
@@ -236,7 +370,7 @@ func (h *HttpHealthChecker) runOnce() {
 	case err != nil:
 		h.status = fmt.Sprintf("error: %s in %s", err, d)
 		h.isHealthy = false
-	case resp.StatusCode != 200:
+	case resp.StatusCode != 200 && !(h.AcceptClientErrors && resp.StatusCode < 500):
 		h.status = fmt.Sprintf("error: status %s in %s", resp.Status, d)
 		h.isHealthy = false
 	default:
@@ -258,15 +392,22 @@ func (h *HttpHealthChecker) Run() {
 	//TODO: make prober timeout configurable
 	h.client = &http.Client{Transport: h.Transport, Timeout: 5 * time.Second}
 	h.ticker = time.NewTicker(10 * time.Second)
+	h.done = make(chan struct{})
 	go func() {
 		h.runOnce()
-		for now := range h.ticker.C {
-			glog.V(2).Infof("Healthcheck request at %v", now)
-			h.runOnce()
+		for {
+			select {
+			case <-h.done:
+				return
+			case now := <-h.ticker.C:
+				glog.V(2).Infof("Healthcheck request at %v", now)
+				h.runOnce()
+			}
 		}
 	}()
 }
 
 func (h *HttpHealthChecker) Stop() {
 	h.ticker.Stop()
+	close(h.done)
 }
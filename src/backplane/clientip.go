@@ -0,0 +1,106 @@
+package backplane
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/apesternikov/backplane/src/ipacl"
+)
+
+// headers a client may use to claim another address
+var forwardingHeaders = []string{"X-Forwarded-For", "X-Real-Ip", "Forwarded"}
+
+// clientIpResolver finds the real client address of requests passed by trusted proxies
+type clientIpResolver struct {
+	trusted *ipacl.Set
+	header  string
+}
+
+func newClientIpResolver(trusted []string, header string) (*clientIpResolver, error) {
+	if header == "" {
+		header = "X-Forwarded-For"
+	}
+	header = http.CanonicalHeaderKey(header)
+	switch header {
+	case "X-Forwarded-For", "X-Real-Ip", "Forwarded":
+	default:
+		return nil, fmt.Errorf("unsupported client_ip_header %s", header)
+	}
+	set, err := ipacl.NewSet(trusted)
+	if err != nil {
+		return nil, err
+	}
+	return &clientIpResolver{trusted: set, header: header}, nil
+}
+
+// resolve returns the client ip of the request. Forwarding headers are taken into account
+// only if the peer is a trusted proxy and removed from the request otherwise, so
+// backends never see values set by clients.
+// Address chains are walked right to left skipping trusted proxies, the first untrusted
+// address is the client.
+func (c *clientIpResolver) resolve(req *http.Request) string {
+	peer := req.RemoteAddr
+	if host, _, err := net.SplitHostPort(peer); err == nil {
+		peer = host
+	}
+	ip := net.ParseIP(peer)
+	if !c.trusted.Contains(ip) {
+		for _, h := range forwardingHeaders {
+			req.Header.Del(h)
+		}
+		return peer
+	}
+	var chain []string
+	switch c.header {
+	case "X-Forwarded-For":
+		for _, v := range req.Header["X-Forwarded-For"] {
+			chain = append(chain, strings.Split(v, ",")...)
+		}
+	case "X-Real-Ip":
+		chain = req.Header["X-Real-Ip"]
+	case "Forwarded":
+		chain = forwardedFor(req.Header["Forwarded"])
+	}
+	client := peer
+	for i := len(chain) - 1; i >= 0; i-- {
+		hop := parseHop(chain[i])
+		if hop == nil {
+			// unknown or obfuscated identifier, the last known hop is as close as we get
+			break
+		}
+		client = hop.String()
+		if !c.trusted.Contains(hop) {
+			break
+		}
+	}
+	return client
+}
+
+// forwardedFor extracts for= parameters of RFC 7239 Forwarded header values
+func forwardedFor(values []string) []string {
+	var chain []string
+	for _, v := range values {
+		for _, elem := range strings.Split(v, ",") {
+			var node string
+			for _, pair := range strings.Split(elem, ";") {
+				kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+				if len(kv) == 2 && strings.EqualFold(kv[0], "for") {
+					node = strings.Trim(kv[1], `"`)
+				}
+			}
+			chain = append(chain, node)
+		}
+	}
+	return chain
+}
+
+// parseHop parses an address optionally followed by port, IPv6 addresses may be in brackets
+func parseHop(s string) net.IP {
+	s = strings.TrimSpace(s)
+	if host, _, err := net.SplitHostPort(s); err == nil {
+		s = host
+	}
+	return net.ParseIP(strings.Trim(s, "[]"))
+}
@@ -0,0 +1,49 @@
+package backplane
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestClientIpResolver(t *testing.T) {
+	testcases := []struct {
+		header   string
+		peer     string
+		values   map[string][]string
+		clientIp string
+		stripped bool
+	}{
+		// untrusted peer, spoofed headers are removed
+		{"", "1.1.1.1:1000", map[string][]string{"X-Forwarded-For": {"6.6.6.6"}, "Forwarded": {"for=6.6.6.6"}}, "1.1.1.1", true},
+		{"", "10.0.0.1:1000", map[string][]string{"X-Forwarded-For": {"6.6.6.6, 2.2.2.2"}}, "2.2.2.2", false},
+		// trusted hops are skipped, leftmost values can be spoofed by the client
+		{"", "10.0.0.1:1000", map[string][]string{"X-Forwarded-For": {"6.6.6.6, 2.2.2.2", "10.0.0.2"}}, "2.2.2.2", false},
+		{"", "10.0.0.1:1000", map[string][]string{"X-Forwarded-For": {"10.0.0.3, 10.0.0.2"}}, "10.0.0.3", false},
+		{"", "10.0.0.1:1000", map[string][]string{"X-Forwarded-For": {"garbage, 10.0.0.2"}}, "10.0.0.2", false},
+		{"", "10.0.0.1:1000", nil, "10.0.0.1", false},
+		// only the configured header is used
+		{"", "10.0.0.1:1000", map[string][]string{"X-Real-Ip": {"2.2.2.2"}}, "10.0.0.1", false},
+		{"x-real-ip", "10.0.0.1:1000", map[string][]string{"X-Real-Ip": {"2.2.2.2"}, "X-Forwarded-For": {"3.3.3.3"}}, "2.2.2.2", false},
+		{"Forwarded", "10.0.0.1:1000", map[string][]string{"Forwarded": {`for=6.6.6.6, for="[2001:db8::1]:4711";proto=https, For=10.0.0.2;by=10.0.0.1`}}, "2001:db8::1", false},
+		{"Forwarded", "10.0.0.1:1000", map[string][]string{"Forwarded": {"for=_hidden, for=10.0.0.2"}}, "10.0.0.2", false},
+	}
+	for i, tc := range testcases {
+		c, err := newClientIpResolver([]string{"10.0.0.0/8"}, tc.header)
+		if err != nil {
+			t.Fatal("Unexpected error: ", err)
+		}
+		req := &http.Request{RemoteAddr: tc.peer, Header: http.Header{}}
+		for k, v := range tc.values {
+			req.Header[k] = v
+		}
+		if ip := c.resolve(req); ip != tc.clientIp {
+			t.Errorf("testcase %d: expected client ip %s got %s", i+1, tc.clientIp, ip)
+		}
+		if stripped := len(req.Header) == 0 && len(tc.values) != 0; stripped != tc.stripped {
+			t.Errorf("testcase %d: expected headers stripped=%v, headers %v", i+1, tc.stripped, req.Header)
+		}
+	}
+	if _, err := newClientIpResolver(nil, "X-Client-Ip"); err == nil {
+		t.Error("expected error for unsupported header")
+	}
+}
@@ -0,0 +1,262 @@
+package backplane
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/net/trace"
+
+	"github.com/apesternikov/backplane/src/backplane/stats"
+	"github.com/apesternikov/backplane/src/config"
+	"github.com/apesternikov/backplane/src/swim"
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+)
+
+// Version of the build announced to cluster members,
+// set with -ldflags "-X github.com/apesternikov/backplane/src/backplane.Version=..."
+var Version = "dev"
+
+// clusterRates keeps cluster_rate limits of all frontends and backends.
+// Until rates are shared with ShareRates every instance enforces the whole maxrate.
+var clusterRates = stats.NewClusterRates()
+
+// how often demand is exchanged and local budgets are rebalanced
+var clusterRatePeriod = time.Second
+
+// newRateLimiter creates the limiter enforcing maxrate. Cluster-wide limiters are registered under name,
+// which must be the same on all cluster members.
+func newRateLimiter(name string, cluster bool, algorithm string, maxrate float64, burst int64) (stats.RateLimiter, error) {
+	if cluster && maxrate > 0 {
+		return clusterRates.RateLimiter(name, maxrate, int(burst)), nil
+	}
+	return stats.NewRateLimiterAlgorithm(algorithm, maxrate, int(burst))
+}
+
+// releaseRateLimiter stops sharing the limiter created by newRateLimiter when its server is removed.
+// The limiter registered again under the same name by a newer config is kept.
+func releaseRateLimiter(name string, rl stats.RateLimiter) {
+	clusterRates.Unregister(name, rl)
+}
+
+// ShareRates starts sharing demand of cluster_rate limits with other cluster members through ex,
+// usually swim.Swim, until Leave. Sharing through the previous ex stops.
+func (bp *Backplane) ShareRates(ex stats.RateExchange) {
+	bp.stopSharingRates()
+	bp.stopRates = make(chan struct{})
+	go clusterRates.Run(ex, clusterRatePeriod, bp.stopRates)
+}
+
+func (bp *Backplane) stopSharingRates() {
+	if bp.stopRates != nil {
+		close(bp.stopRates)
+		bp.stopRates = nil
+	}
+}
+
+// joinCluster starts the membership protocol, announces metadata and joins through seeds.
+// The node becomes the source of cluster_servers and shares cluster_rate limits.
+func (bp *Backplane) joinCluster(cf *config.Cluster, meta map[string]string) error {
+	s, err := swim.NewSwim(cf.Bind)
+	if err != nil {
+		return err
+	}
+	if err := s.SetMeta(meta); err != nil {
+		s.Close()
+		return err
+	}
+	if len(cf.EncryptKey) > 0 {
+		var keys [][]byte
+		for _, k := range cf.EncryptKey {
+			key, err := base64.StdEncoding.DecodeString(k)
+			if err != nil {
+				s.Close()
+				return err
+			}
+			keys = append(keys, key)
+		}
+		keyring, err := swim.NewKeyring(keys...)
+		if err != nil {
+			s.Close()
+			return err
+		}
+		s.SetKeyring(keyring)
+	}
+	if err := s.SetConfig(swimConfig(cf)); err != nil {
+		s.Close()
+		return err
+	}
+	s.AddHosts(resolveSeeds(cf.Seed, cf.Bind)...)
+	go s.Serve()
+	bp.swim = s
+	bp.clusterCf = cf
+	bp.Cluster = s
+	bp.ShareRates(s)
+	return nil
+}
+
+// clusterChanged tells if the cluster config differs from the one the node joined with in anything
+// but tags. Tags are announced with metadata, other changes are not applied until restart.
+func clusterChanged(joined, cf *config.Cluster) bool {
+	a, b := proto.Clone(joined).(*config.Cluster), proto.Clone(cf).(*config.Cluster)
+	a.Tags, b.Tags = nil, nil
+	return !proto.Equal(a, b)
+}
+
+// clusterMeta returns metadata announced by the node: build version, names of configured backends
+// and the address of the first http frontend. cluster tags override them.
+func clusterMeta(cf *config.Config) map[string]string {
+	meta := map[string]string{swim.MetaVersion: Version}
+	var backends []string
+	for _, b := range cf.HttpBackend {
+		backends = append(backends, b.Name)
+	}
+	if len(backends) > 0 {
+		meta[swim.MetaBackends] = strings.Join(backends, ",")
+	}
+	for _, f := range cf.HttpFrontend {
+		if f.BindHttp != "" {
+			if addr := advertisedAddr(f.BindHttp, cf.Cluster.Bind); addr != "" {
+				meta[swim.MetaHttpAddr] = addr
+			}
+			break
+		}
+	}
+	for k, v := range cf.Cluster.Tags {
+		meta[k] = v
+	}
+	return meta
+}
+
+// address of the listener bound to bind as reachable by other members. Listeners on all interfaces
+// are reachable at the ip of the cluster bind
+func advertisedAddr(bind, clusterBind string) string {
+	host, port, err := net.SplitHostPort(bind)
+	if err != nil {
+		glog.Errorf("Bad bind address %s: %s", bind, err)
+		return ""
+	}
+	if ip := net.ParseIP(host); host == "" || ip != nil && ip.IsUnspecified() {
+		if host, _, err = net.SplitHostPort(clusterBind); err != nil {
+			return ""
+		}
+	}
+	return net.JoinHostPort(host, port)
+}
+
+// protocol parameters of the cluster, zero values keep defaults
+func swimConfig(cf *config.Cluster) swim.Config {
+	c := swim.DefaultConfig()
+	if cf.ProbeIntervalMs > 0 {
+		c.ProbeInterval = time.Duration(cf.ProbeIntervalMs) * time.Millisecond
+	}
+	if cf.ProbeTimeoutMs > 0 {
+		c.ProbeTimeout = time.Duration(cf.ProbeTimeoutMs) * time.Millisecond
+	}
+	if cf.IndirectProbes > 0 {
+		c.IndirectProbes = int(cf.IndirectProbes)
+	}
+	if cf.SuspicionMult > 0 {
+		c.SuspicionMult = int(cf.SuspicionMult)
+	}
+	return c
+}
+
+// swim nodes are named by ip:port, resolve seed host names except the node itself
+func resolveSeeds(seeds []string, self string) []string {
+	var hosts []string
+	for _, seed := range seeds {
+		host, port, err := net.SplitHostPort(seed)
+		if err != nil {
+			glog.Errorf("Bad cluster seed %s: %s", seed, err)
+			continue
+		}
+		ips, err := net.LookupHost(host)
+		if err != nil {
+			glog.Errorf("Unable to resolve cluster seed %s: %s", seed, err)
+			continue
+		}
+		for _, ip := range ips {
+			if hostport := net.JoinHostPort(ip, port); hostport != self {
+				hosts = append(hosts, hostport)
+			}
+		}
+	}
+	return hosts
+}
+
+// Leave announces other cluster members this instance is going away, so they do not have to wait
+// for failure detection, and stops the membership protocol
+func (bp *Backplane) Leave() {
+	bp.stopSharingRates()
+	if bp.swim == nil {
+		return
+	}
+	if err := bp.swim.Leave(); err != nil {
+		glog.Error("Unable to leave the cluster: ", err)
+	}
+	bp.swim.Close()
+}
+
+// cluster member as shown by internalcluster
+type clusterMember struct {
+	Name        string            `json:"name"`
+	State       string            `json:"state"`
+	Incarnation int64             `json:"incarnation"`
+	LastChanged time.Time         `json:"last_changed"`
+	Meta        map[string]string `json:"meta,omitempty"`
+}
+
+// handleCluster shows cluster members, in JSON with ?format=json
+func (bp *Backplane) handleCluster(w http.ResponseWriter, req *http.Request) {
+	tr := trace.New("backend.internalcluster", req.RequestURI)
+	defer tr.Finish()
+	if bp.swim == nil {
+		http.Error(w, "cluster is not configured", http.StatusNotFound)
+		return
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		glog.Error("Unable to obtain hostname: ", err)
+		tr.LazyPrintf("Unable to obtain hostname: %s", err)
+	}
+	members := bp.swim.Members()
+	var data = struct {
+		Name         string          `json:"name"`
+		Hostname     string          `json:"hostname"`
+		Encrypted    bool            `json:"encrypted"`
+		AuthFailures int64           `json:"auth_failures"`
+		Members      []clusterMember `json:"members"`
+	}{
+		Name:         members[0].Name,
+		Hostname:     hostname,
+		Encrypted:    bp.swim.Encrypted(),
+		AuthFailures: bp.swim.AuthFailures(),
+		Members:      make([]clusterMember, 0, len(members)),
+	}
+	for _, m := range members {
+		data.Members = append(data.Members, clusterMember{
+			Name:        m.Name,
+			State:       m.State.String(),
+			Incarnation: m.Incarnation,
+			LastChanged: m.LastChanged,
+			Meta:        m.Meta,
+		})
+	}
+
+	if req.FormValue("format") == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(&data)
+	} else {
+		err = ClusterTemplate().Execute(w, &data)
+	}
+	if err != nil {
+		glog.Errorf("unable to render cluster status: %s", err)
+		tr.LazyPrintf("unable to render cluster status: %s", err)
+	}
+}
@@ -0,0 +1,153 @@
+package backplane
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/apesternikov/backplane/src/config"
+	"github.com/apesternikov/backplane/src/swim"
+)
+
+func TestSwimConfig(t *testing.T) {
+	if c := swimConfig(&config.Cluster{}); c != swim.DefaultConfig() {
+		t.Errorf("expected default config, got %v", c)
+	}
+	c := swimConfig(&config.Cluster{ProbeIntervalMs: 500, ProbeTimeoutMs: 100, IndirectProbes: 5, SuspicionMult: 8})
+	if c.ProbeInterval != 500*time.Millisecond || c.ProbeTimeout != 100*time.Millisecond || c.IndirectProbes != 5 || c.SuspicionMult != 8 {
+		t.Errorf("unexpected config %v", c)
+	}
+}
+
+func TestClusterMeta(t *testing.T) {
+	cf := &config.Config{
+		HttpFrontend: []*config.HttpFrontend{{Name: "admin"}, {Name: "web", BindHttp: ":8080"}},
+		HttpBackend:  []*config.HttpBackend{{Name: "be1"}, {Name: "be2"}},
+		Cluster:      &config.Cluster{Bind: "10.0.0.1:7946"},
+	}
+	meta := clusterMeta(cf)
+	if meta[swim.MetaVersion] != Version || meta[swim.MetaBackends] != "be1,be2" || meta[swim.MetaHttpAddr] != "10.0.0.1:8080" {
+		t.Errorf("unexpected metadata %v", meta)
+	}
+	cf.HttpFrontend[1].BindHttp = "192.168.0.1:80"
+	cf.Cluster.Tags = map[string]string{swim.MetaBackends: "be1", swim.MetaRole: "edge"}
+	meta = clusterMeta(cf)
+	if meta[swim.MetaBackends] != "be1" || meta[swim.MetaRole] != "edge" || meta[swim.MetaHttpAddr] != "192.168.0.1:80" {
+		t.Errorf("expected tags to override metadata, got %v", meta)
+	}
+}
+
+func TestClusterChanged(t *testing.T) {
+	joined := &config.Cluster{Bind: "127.0.0.1:7946", Seed: []string{"a:7946"}, Tags: map[string]string{"dc": "east"}}
+	if clusterChanged(joined, &config.Cluster{Bind: "127.0.0.1:7946", Seed: []string{"a:7946"}, Tags: map[string]string{"dc": "west"}}) {
+		t.Error("tags change is applied without restart")
+	}
+	if !clusterChanged(joined, &config.Cluster{Bind: "127.0.0.1:7946", Seed: []string{"b:7946"}}) {
+		t.Error("expected seeds change to be detected")
+	}
+	if joined.Tags["dc"] != "east" {
+		t.Error("joined config modified")
+	}
+}
+
+func TestResolveSeeds(t *testing.T) {
+	hosts := resolveSeeds([]string{"127.0.0.1:7946", "localhost:7947", "bad", "10.0.0.1:7946"}, "127.0.0.1:7946")
+	found := false
+	for _, h := range hosts {
+		if h == "127.0.0.1:7946" {
+			t.Error("expected the node itself to be skipped")
+		}
+		found = found || h == "127.0.0.1:7947"
+	}
+	if !found || hosts[len(hosts)-1] != "10.0.0.1:7946" {
+		t.Errorf("unexpected seeds %v", hosts)
+	}
+}
+
+func TestClusterStatus(t *testing.T) {
+	bp := &Backplane{}
+	if err := bp.Configure(&config.Config{}); err != nil {
+		t.Fatal(err)
+	}
+	rec := httptest.NewRecorder()
+	bp.handleCluster(rec, httptest.NewRequest("GET", "/cluster", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected not found without cluster config, got %d", rec.Code)
+	}
+
+	bp = &Backplane{}
+	err := bp.Configure(&config.Config{Cluster: &config.Cluster{
+		Bind:       "127.0.0.1:0",
+		Tags:       map[string]string{"backends": "be1"},
+		EncryptKey: []string{"MDEyMzQ1Njc4OWFiY2RlZg=="},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bp.Leave()
+	if bp.Cluster == nil {
+		t.Fatal("expected cluster membership to be set")
+	}
+	rec = httptest.NewRecorder()
+	bp.handleCluster(rec, httptest.NewRequest("GET", "/cluster?format=json", nil))
+	var status struct {
+		Name      string
+		Encrypted bool
+		Members   []clusterMember
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &status); err != nil {
+		t.Fatal(err, rec.Body.String())
+	}
+	if !status.Encrypted || !bp.swim.Encrypted() {
+		t.Error("expected encryption to be enabled")
+	}
+	if len(status.Members) != 1 {
+		t.Fatalf("unexpected members %v", status.Members)
+	}
+	if m := status.Members[0]; m.Name != status.Name || m.State != "ALIVE" || m.Meta["backends"] != "be1" || m.Incarnation != 1 {
+		t.Errorf("unexpected member %v", m)
+	}
+
+	rec = httptest.NewRecorder()
+	bp.handleCluster(rec, httptest.NewRequest("GET", "/cluster", nil))
+	if body := rec.Body.String(); !strings.Contains(body, status.Name+" [self]") || !strings.Contains(body, "backends=be1") {
+		t.Errorf("unexpected page %s", body)
+	}
+}
+
+type countingExchange struct {
+	calls int32
+}
+
+func (e *countingExchange) ExchangeRates(local map[string]float64) map[string]map[string]float64 {
+	atomic.AddInt32(&e.calls, 1)
+	return nil
+}
+
+func TestShareRatesStopsOnLeave(t *testing.T) {
+	defer func(period time.Duration) { clusterRatePeriod = period }(clusterRatePeriod)
+	clusterRatePeriod = time.Millisecond
+	bp := &Backplane{}
+	first, second := &countingExchange{}, &countingExchange{}
+	bp.ShareRates(first)
+	bp.ShareRates(second)
+	time.Sleep(20 * time.Millisecond)
+	bp.Leave()
+	time.Sleep(5 * time.Millisecond)
+	calls := atomic.LoadInt32(&second.calls)
+	if calls == 0 {
+		t.Fatal("expected rates to be exchanged")
+	}
+	stopped := atomic.LoadInt32(&first.calls)
+	time.Sleep(20 * time.Millisecond)
+	if c := atomic.LoadInt32(&second.calls); c != calls {
+		t.Errorf("rates exchanged after leave: %d calls, %d before", c, calls)
+	}
+	if c := atomic.LoadInt32(&first.calls); c != stopped {
+		t.Errorf("replaced exchange still used: %d calls, %d before", c, stopped)
+	}
+}
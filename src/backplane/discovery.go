@@ -0,0 +1,126 @@
+package backplane
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/apesternikov/backplane/src/config"
+	"github.com/apesternikov/backplane/src/swim"
+	"github.com/golang/glog"
+)
+
+// Membership is the source of cluster members, usually swim.Swim
+type Membership interface {
+	Members() []swim.Member
+	Subscribe(ch chan<- swim.Event)
+	Unsubscribe(ch chan<- swim.Event)
+}
+
+// default metadata key of advertised server address
+const defaultAddressMeta = swim.MetaHttpAddr
+
+// hasTag tells if the metadata has the key=value tag. Metadata value may be a comma separated list
+func hasTag(meta map[string]string, tag string) bool {
+	kv := strings.SplitN(tag, "=", 2)
+	if len(kv) != 2 {
+		return false
+	}
+	for _, v := range strings.Split(meta[kv[0]], ",") {
+		if strings.TrimSpace(v) == kv[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// DiscoverServers keeps discovered servers of the backend in sync with live members of the cluster
+// carrying the cluster_servers tag, until the backend is stopped.
+// Servers are removed when members are confirmed dead or leave, health checks still apply.
+func (b *Backend) DiscoverServers(m Membership) {
+	events := make(chan swim.Event, 16)
+	done := make(chan struct{})
+	m.Subscribe(events)
+	b.syncServers(m.Members())
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-events:
+				// the whole membership is compared, so events coming in bursts are applied at once
+				for len(events) > 0 {
+					<-events
+				}
+				b.syncServers(m.Members())
+			}
+		}
+	}()
+	b.mu.Lock()
+	b.stopDiscovery = func() {
+		m.Unsubscribe(events)
+		close(done)
+	}
+	b.mu.Unlock()
+}
+
+// add servers of new members and remove servers of members gone
+func (b *Backend) syncServers(members []swim.Member) {
+	cs := b.Cf.ClusterServers
+	tag := cs.Tag
+	if tag == "" {
+		tag = swim.MetaBackends + "=" + b.Cf.Name
+	}
+	key := cs.AddressMeta
+	if key == "" {
+		key = defaultAddressMeta
+	}
+	wanted := make(map[string]bool)
+	for _, m := range members {
+		if !m.Up() || !hasTag(m.Meta, tag) {
+			continue
+		}
+		addr := m.Meta[key]
+		if addr == "" {
+			glog.Errorf("backend %s: cluster member %s does not advertise %s", b.Cf.Name, m.Name, key)
+			continue
+		}
+		wanted[addr] = true
+	}
+
+	b.mu.Lock()
+	discovered := make([]*Server, 0, len(wanted))
+	var removed []*Server
+	for _, s := range b.discovered {
+		if wanted[s.Cf.Address] {
+			discovered = append(discovered, s)
+			delete(wanted, s.Cf.Address)
+		} else {
+			removed = append(removed, s)
+		}
+	}
+	for addr := range wanted {
+		scf := &config.Server{}
+		if cs.Server != nil {
+			*scf = *cs.Server
+		}
+		scf.Address = addr
+		glog.Infof("backend %s: discovered server %s", b.Cf.Name, addr)
+		discovered = append(discovered, NewServer(b.Cf, scf, b.balancer.rebuildActive))
+	}
+	sort.Sort(byAddress(discovered))
+	b.discovered = discovered
+	servers := append(append([]*Server(nil), b.Servers...), discovered...)
+	b.mu.Unlock()
+
+	b.balancer.setHandlers(servers)
+	for _, s := range removed {
+		glog.Infof("backend %s: removed server %s", b.Cf.Name, s.Cf.Address)
+		s.Stop()
+	}
+}
+
+type byAddress []*Server
+
+func (s byAddress) Len() int           { return len(s) }
+func (s byAddress) Less(i, j int) bool { return s[i].Cf.Address < s[j].Cf.Address }
+func (s byAddress) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,216 @@
+package backplane
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apesternikov/backplane/src/config"
+	"github.com/apesternikov/backplane/src/gen"
+	"github.com/apesternikov/backplane/src/swim"
+)
+
+type fakeMembership struct {
+	mu          sync.Mutex
+	members     []swim.Member
+	subscribers []chan<- swim.Event
+}
+
+func (m *fakeMembership) Members() []swim.Member {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]swim.Member(nil), m.members...)
+}
+
+func (m *fakeMembership) Subscribe(ch chan<- swim.Event) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.subscribers = append(m.subscribers, ch)
+}
+
+func (m *fakeMembership) Unsubscribe(ch chan<- swim.Event) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, sub := range m.subscribers {
+		if sub == ch {
+			m.subscribers = append(m.subscribers[:i], m.subscribers[i+1:]...)
+			return
+		}
+	}
+}
+
+func (m *fakeMembership) set(member swim.Member) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.members {
+		if m.members[i].Name == member.Name {
+			m.members[i] = member
+			member = swim.Member{}
+		}
+	}
+	if member.Name != "" {
+		m.members = append(m.members, member)
+	}
+	for _, ch := range m.subscribers {
+		ch <- swim.Event{Member: member}
+	}
+}
+
+func TestHasTag(t *testing.T) {
+	meta := map[string]string{"backends": "api, web", "role": "app"}
+	for tag, expected := range map[string]bool{
+		"backends=api": true,
+		"backends=web": true,
+		"backends=ap":  false,
+		"role=app":     true,
+		"role=db":      false,
+		"dc=":          true,
+		"role":         false,
+	} {
+		if hasTag(meta, tag) != expected {
+			t.Errorf("%s: expected %v", tag, expected)
+		}
+	}
+}
+
+// wait for discovered servers, they are sorted by address
+func waitServers(t *testing.T, be *Backend, expected ...string) {
+	sort.Strings(expected)
+	var addrs []string
+	for i := 0; i < 100; i++ {
+		addrs = addrs[:0]
+		for _, s := range be.GetServers() {
+			addrs = append(addrs, s.Cf.Address)
+		}
+		if fmt.Sprint(addrs) == fmt.Sprint(expected) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("expected servers %v got %v", expected, addrs)
+}
+
+func TestDiscoverServers(t *testing.T) {
+	var upstreams []*httptest.Server
+	for i := 0; i < 2; i++ {
+		i := i
+		upstreams = append(upstreams, httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, "upstream%d", i)
+		})))
+		defer upstreams[i].Close()
+	}
+	addr0, addr1 := upstreams[0].Listener.Addr().String(), upstreams[1].Listener.Addr().String()
+	m := &fakeMembership{}
+	m.set(swim.Member{Name: "n0", Meta: map[string]string{swim.MetaBackends: "other,be1", swim.MetaHttpAddr: addr0}})
+	m.set(swim.Member{Name: "n1", Meta: map[string]string{swim.MetaRole: "db", swim.MetaHttpAddr: addr1}})
+
+	be, err := NewBackend(&config.HttpBackend{
+		Name:           "be1",
+		ClusterServers: &config.ClusterServers{Server: &config.Server{Maxconn: 10}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	be.DiscoverServers(m)
+	defer be.Stop()
+	waitServers(t, be, addr0)
+	s0 := be.GetServers()[0]
+	if s0.Cf.Maxconn != 10 || s0.Limiter.Limit() != 10 {
+		t.Errorf("expected server settings to be applied, got %v", s0.Cf)
+	}
+
+	f, err := NewFrontend(mustFEFromText(`
+		bind_http: ":80"
+		host: <
+			default: true
+			handler: <
+				path: "/"
+				backend_name: "be1"
+				>
+			 >
+		`), func(name string) http.Handler { return be })
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	front := httptest.NewServer(f)
+	defer front.Close()
+	get := func() string {
+		resp, err := http.Get(front.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+	for i := 0; i < 100 && !s0.IsHealthy(); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if body := get(); body != "upstream0" {
+		t.Errorf("unexpected response %q", body)
+	}
+
+	// the member starts serving the backend
+	m.set(swim.Member{Name: "n1", Meta: map[string]string{swim.MetaBackends: "be1", swim.MetaHttpAddr: addr1}})
+	waitServers(t, be, addr0, addr1)
+	kept := false
+	for _, s := range be.GetServers() {
+		kept = kept || s == s0
+	}
+	if !kept {
+		t.Error("expected the server to be kept")
+	}
+	// suspected members keep serving, dead are removed
+	m.set(swim.Member{Name: "n0", State: gen.NodeState_SUSPECT, Meta: map[string]string{swim.MetaBackends: "be1", swim.MetaHttpAddr: addr0}})
+	m.set(swim.Member{Name: "n1", State: gen.NodeState_DEAD, Meta: map[string]string{swim.MetaBackends: "be1", swim.MetaHttpAddr: addr1}})
+	waitServers(t, be, addr0)
+	if body := get(); body != "upstream0" {
+		t.Errorf("unexpected response %q", body)
+	}
+	m.set(swim.Member{Name: "n0", State: gen.NodeState_DEAD, Meta: map[string]string{swim.MetaBackends: "be1", swim.MetaHttpAddr: addr0}})
+	waitServers(t, be)
+	if resp, err := http.Get(front.URL); err != nil || resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected no servers available, got %v %v", resp, err)
+	}
+	be.Stop()
+	if len(m.subscribers) != 0 {
+		t.Error("expected stopped backend to unsubscribe")
+	}
+}
+
+type publishingExchange struct {
+	published map[string]float64
+}
+
+func (e *publishingExchange) ExchangeRates(local map[string]float64) map[string]map[string]float64 {
+	e.published = local
+	return nil
+}
+
+func TestDiscoveredServerRates(t *testing.T) {
+	be, err := NewBackend(&config.HttpBackend{
+		Name:           "be-rates",
+		ClusterServers: &config.ClusterServers{Server: &config.Server{Maxrate: 10, ClusterRate: true}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer be.Stop()
+	name := "backend be-rates server 127.0.0.1:1"
+	be.syncServers([]swim.Member{{Name: "n0", Meta: map[string]string{swim.MetaBackends: "be-rates", swim.MetaHttpAddr: "127.0.0.1:1"}}})
+	ex := &publishingExchange{}
+	clusterRates.Rebalance(ex)
+	if _, ok := ex.published[name]; !ok {
+		t.Errorf("expected cluster rate of discovered server to be shared, got %v", ex.published)
+	}
+	be.syncServers(nil)
+	clusterRates.Rebalance(ex)
+	if _, ok := ex.published[name]; ok {
+		t.Errorf("expected cluster rate of removed server to be dropped, got %v", ex.published)
+	}
+}
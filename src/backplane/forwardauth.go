@@ -0,0 +1,235 @@
+package backplane
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/apesternikov/backplane/src/config"
+	"github.com/apesternikov/backplane/src/context"
+	"github.com/apesternikov/backplane/src/requestlog"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/trace"
+)
+
+var defaultForwardRequestHeaders = []string{"Authorization", "Cookie"}
+
+const (
+	// auth service responses larger than that are truncated
+	maxForwardAuthResponse = 64 * 1024
+	// upper bound on the number of cached auth results
+	maxForwardAuthCache = 10000
+)
+
+// forwardAuthWrapper asks an external service whether the request is allowed
+// by sending a subrequest with the original method, uri and selected headers to a backend
+type forwardAuthWrapper struct {
+	Config         *config.AuthForwardT
+	Handler        http.Handler
+	authBackend    http.Handler
+	requestHeaders []string
+	cacheKeyHeader string
+	cache          *forwardAuthCache
+}
+
+func newForwardAuthWrapper(cf *config.AuthForwardT, h http.Handler, backends HandlersMap) (*forwardAuthWrapper, error) {
+	f := &forwardAuthWrapper{
+		Config:         cf,
+		Handler:        h,
+		authBackend:    backends(cf.BackendName),
+		requestHeaders: cf.RequestHeader,
+		cacheKeyHeader: cf.CacheKeyHeader,
+	}
+	if f.authBackend == nil {
+		return nil, fmt.Errorf("Unknown forward auth backend %s", cf.BackendName)
+	}
+	if len(f.requestHeaders) == 0 {
+		f.requestHeaders = defaultForwardRequestHeaders
+	}
+	if f.cacheKeyHeader == "" {
+		f.cacheKeyHeader = "Authorization"
+	}
+	if cf.CacheSeconds > 0 {
+		f.cache = newForwardAuthCache(time.Duration(cf.CacheSeconds) * time.Second)
+	}
+	return f, nil
+}
+
+// authResult is the part of the auth service response we act upon
+type authResult struct {
+	code    int
+	header  http.Header // full response header for denied requests, whitelisted headers otherwise
+	body    []byte
+	expires time.Time
+}
+
+func (a *authResult) allowed() bool {
+	return a.code >= 200 && a.code < 300
+}
+
+// bufferedResponseWriter collects the subrequest response in memory
+type bufferedResponseWriter struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponseWriter) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponseWriter) WriteHeader(code int) {
+	if b.code == 0 {
+		b.code = code
+	}
+}
+
+func (b *bufferedResponseWriter) Write(p []byte) (int, error) {
+	b.WriteHeader(http.StatusOK)
+	if room := maxForwardAuthResponse - b.body.Len(); room > 0 {
+		if len(p) > room {
+			b.body.Write(p[:room])
+		} else {
+			b.body.Write(p)
+		}
+	}
+	return len(p), nil
+}
+
+func (f *forwardAuthWrapper) check(r *http.Request) *authResult {
+	// the subrequest is cancelled with the client request
+	sub, err := http.NewRequestWithContext(r.Context(), r.Method, r.URL.String(), nil)
+	if err != nil {
+		return &authResult{code: http.StatusInternalServerError}
+	}
+	sub.Host = r.Host
+	sub.RequestURI = r.RequestURI
+	sub.RemoteAddr = r.RemoteAddr
+	for _, name := range f.requestHeaders {
+		if v, ok := r.Header[http.CanonicalHeaderKey(name)]; ok {
+			sub.Header[http.CanonicalHeaderKey(name)] = v
+		}
+	}
+	log := &requestlog.Item{}
+	var tr trace.Trace
+	if ctx := context.GetRequestContext(r); ctx != nil {
+		log.ClientIp = ctx.Log.ClientIp
+		log.Frontend = ctx.Log.Frontend
+		tr = ctx.Tr
+	}
+	if tr == nil {
+		tr = trace.New("forwardauth."+f.Config.BackendName, r.RequestURI)
+		defer tr.Finish()
+	}
+	tr.LazyPrintf("forward auth subrequest to %s", f.Config.BackendName)
+	context.NewRequestContext(sub, &context.RequestContext{Log: log, Tr: tr})
+	defer context.Clear(sub)
+
+	w := &bufferedResponseWriter{header: make(http.Header)}
+	f.authBackend.ServeHTTP(w, sub)
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+	tr.LazyPrintf("forward auth response %d", w.code)
+	res := &authResult{code: w.code}
+	if res.allowed() {
+		res.header = make(http.Header)
+		for _, name := range f.Config.ResponseHeader {
+			if v, ok := w.header[http.CanonicalHeaderKey(name)]; ok {
+				res.header[http.CanonicalHeaderKey(name)] = v
+			}
+		}
+	} else {
+		res.header = w.header
+		res.body = w.body.Bytes()
+	}
+	return res
+}
+
+// cacheKey identifies the auth result of the request: the same credentials may be allowed
+// for one resource and denied for another. Empty if the request has no cache key header.
+func (f *forwardAuthWrapper) cacheKey(r *http.Request) string {
+	v := r.Header.Get(f.cacheKeyHeader)
+	if v == "" {
+		return ""
+	}
+	return r.Method + " " + r.Host + r.RequestURI + " " + v
+}
+
+func (f *forwardAuthWrapper) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// headers set by the auth service are never taken from the client
+	for _, name := range f.Config.ResponseHeader {
+		r.Header.Del(name)
+	}
+	key := f.cacheKey(r)
+	var res *authResult
+	if key != "" {
+		res = f.cache.get(key)
+	}
+	if res == nil {
+		res = f.check(r)
+		// never cache auth service failures
+		if key != "" && (res.allowed() || res.code == 401 || res.code == 403) {
+			f.cache.put(key, res)
+		}
+	}
+	if !res.allowed() {
+		glog.V(2).Infof("forward auth denied %s %s from %s: %d", r.Method, r.RequestURI, requestClientIp(r), res.code)
+		copyHeader(w.Header(), res.header)
+		w.WriteHeader(res.code)
+		w.Write(res.body)
+		return
+	}
+	for name, v := range res.header {
+		r.Header[name] = v
+	}
+	f.Handler.ServeHTTP(w, r)
+}
+
+// forwardAuthCache keeps auth results for a short time. nil *forwardAuthCache never caches.
+type forwardAuthCache struct {
+	ttl       time.Duration
+	mu        sync.Mutex
+	entries   map[string]*authResult
+	lastPurge time.Time
+}
+
+func newForwardAuthCache(ttl time.Duration) *forwardAuthCache {
+	return &forwardAuthCache{ttl: ttl, entries: make(map[string]*authResult), lastPurge: time.Now()}
+}
+
+func (c *forwardAuthCache) get(key string) *authResult {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if res, ok := c.entries[key]; ok && time.Now().Before(res.expires) {
+		return res
+	}
+	return nil
+}
+
+func (c *forwardAuthCache) put(key string, res *authResult) {
+	if c == nil {
+		return
+	}
+	now := time.Now()
+	res.expires = now.Add(c.ttl)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if now.Sub(c.lastPurge) >= c.ttl || len(c.entries) >= maxForwardAuthCache {
+		c.lastPurge = now
+		for k, e := range c.entries {
+			if now.After(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	if len(c.entries) < maxForwardAuthCache {
+		c.entries[key] = res
+	}
+}
@@ -9,13 +9,14 @@ import (
 	"time"
 
 	"github.com/apesternikov/backplane/src/context"
+	"github.com/apesternikov/backplane/src/ipacl"
+	"github.com/apesternikov/backplane/src/proxyproto"
 
 	"github.com/apesternikov/backplane/src/requestlog"
 
+	"golang.org/x/net/http2"
 	"golang.org/x/net/trace"
 
-	"github.com/bradfitz/http2"
-
 	"github.com/apesternikov/backplane/src/backplane/stats"
 
 	"github.com/apesternikov/backplane/src/config"
@@ -24,13 +25,12 @@ import (
 
 type HandlersMap func(name string) http.Handler
 
-var FIXME_RATE_LIMIT = 1000000.
-
 type Vhost struct {
 	Cf *config.HttpFrontendVhost
 	stats.Counting
 	RateLimiter stats.RateLimiter
 	Limiter     stats.Limiter
+	ACL         *ipacl.ACL
 	Routes      []*Route
 }
 
@@ -39,6 +39,7 @@ type Route struct {
 	stats.Counting
 	RateLimiter stats.RateLimiter
 	Limiter     stats.Limiter
+	ACL         *ipacl.ACL
 }
 
 type Frontend struct {
@@ -51,7 +52,11 @@ type Frontend struct {
 	stats.Counting
 	RateLimiter stats.RateLimiter
 	Vhosts      []*Vhost
+	ACL         *ipacl.ACL
+	proxyFrom   *ipacl.Set // peers trusted to send PROXY protocol headers
+	clientIp    *clientIpResolver
 	tlsconf     *tls.Config
+	stapler     *OCSPStapler
 }
 
 func init() {
@@ -72,12 +77,7 @@ func (f *Frontend) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		ServerName:     f.Cf.ServerString,
 		Ctx:            &ctx,
 	}
-	host, _, err := net.SplitHostPort(req.RemoteAddr)
-	if err == nil {
-		log.ClientIp = host
-	} else {
-		log.ClientIp = req.RemoteAddr
-	}
+	log.ClientIp = f.clientIp.resolve(req)
 	log.TimeTNs = time.Now().UnixNano()
 	log.Method = req.Method
 	log.RequestUri = req.RequestURI
@@ -94,6 +94,7 @@ func (f *Frontend) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 
 	log.StatusCode = int64(resp.ResponseCode)
 	log.ResponseSize = int64(resp.ResponseSize)
+	log.GrpcStatus = resp.GrpcStatus()
 	endtime := time.Now().UnixNano()
 	log.FrontendLatencyNs = endtime - log.TimeTNs
 	tr.LazyPrintf("Response code %d", resp.ResponseCode)
@@ -108,14 +109,47 @@ func (f *Frontend) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	context.Clear(req)
 }
 
+// requestClientIp returns the client ip detected by the frontend for the request
+func requestClientIp(r *http.Request) string {
+	if ctx := context.GetRequestContext(r); ctx != nil && ctx.Log.ClientIp != "" {
+		return ctx.Log.ClientIp
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
 func NewFrontend(cf *config.HttpFrontend, backends HandlersMap) (*Frontend, error) {
 	var err error
 	hs := &HostSwitch{handlers: make(map[string]http.Handler)}
+	var fhandler http.Handler = hs
+	var facl *ipacl.ACL
+	if cf.Acl != nil {
+		facl, err = newACL(cf.Acl)
+		if err != nil {
+			return nil, fmt.Errorf("frontend %s: %s", cf.Name, err)
+		}
+		fhandler = &aclWrapper{ACL: facl, Handler: fhandler}
+	}
 	chs := &stats.CountersCollectingHandler{
-		Handler:     hs,
-		RateLimiter: stats.NewRateLimiter(FIXME_RATE_LIMIT),
+		Handler:     fhandler,
+		RateLimiter: stats.NewUnlimitedRateLimiter(),
+	}
+	f := &Frontend{Cf: cf, Handler: chs, Counting: chs, RateLimiter: chs.RateLimiter, ACL: facl}
+	f.clientIp, err = newClientIpResolver(cf.TrustedProxies, cf.ClientIpHeader)
+	if err != nil {
+		return nil, fmt.Errorf("frontend %s: %s", cf.Name, err)
+	}
+	if cf.AcceptProxyProtocol {
+		if len(cf.ProxyProtocolFrom) == 0 {
+			return nil, fmt.Errorf("frontend %s: accept_proxy_protocol requires proxy_protocol_from", cf.Name)
+		}
+		f.proxyFrom, err = ipacl.NewSet(cf.ProxyProtocolFrom)
+		if err != nil {
+			return nil, fmt.Errorf("frontend %s: %s", cf.Name, err)
+		}
 	}
-	f := &Frontend{Cf: cf, Handler: chs, Counting: chs, RateLimiter: chs.RateLimiter}
 
 	if cf.BindHttp == "" {
 		return nil, fmt.Errorf("frontend %s: Bind address is empty", cf.Name)
@@ -124,10 +158,35 @@ func NewFrontend(cf *config.HttpFrontend, backends HandlersMap) (*Frontend, erro
 		vhost := &Vhost{Cf: vh}
 		f.Vhosts = append(f.Vhosts, vhost)
 		mux := http.NewServeMux()
+		var vhandler http.Handler = mux
+		if vh.Hsts != nil {
+			vhandler = newHstsWrapper(vh.Hsts, vhandler)
+		}
+		if vh.HttpsRedirect != nil {
+			vhandler = &httpsRedirectWrapper{Config: vh.HttpsRedirect, Handler: vhandler}
+		}
+		for _, rl := range vh.ClientRateLimit {
+			vhandler, err = newClientRateLimitWrapper(rl, false, vhandler)
+			if err != nil {
+				return nil, fmt.Errorf("frontend %s host %d: %s", cf.Name, i+1, err)
+			}
+		}
+		if vh.Acl != nil {
+			vhost.ACL, err = newACL(vh.Acl)
+			if err != nil {
+				return nil, fmt.Errorf("frontend %s host %d: %s", cf.Name, i+1, err)
+			}
+			vhandler = &aclWrapper{ACL: vhost.ACL, Handler: vhandler}
+		}
+		vname := fmt.Sprintf("frontend %s host %d", cf.Name, i+1)
+		vrl, err := newRateLimiter(vname, vh.ClusterRate, vh.RateAlgorithm, vh.Maxrate, vh.RateBurst)
+		if err != nil {
+			return nil, fmt.Errorf("frontend %s host %d: %s", cf.Name, i+1, err)
+		}
 		cmux := &stats.CountersCollectingHandler{
-			Handler:     mux,
-			RateLimiter: stats.NewRateLimiter(vh.Maxrate),
-			Limiter:     stats.NewLimiter(int(vh.Maxconn)),
+			Handler:     vhandler,
+			RateLimiter: vrl,
+			Limiter:     stats.NewQueueLimiter(int(vh.Maxconn), int(vh.Maxqueue), time.Duration(vh.QueueTimeoutMs)*time.Millisecond),
 		}
 		vhost.Counting = cmux
 		vhost.RateLimiter = cmux.RateLimiter
@@ -146,19 +205,49 @@ func NewFrontend(cf *config.HttpFrontend, backends HandlersMap) (*Frontend, erro
 			if h == nil {
 				return nil, fmt.Errorf("Unknown backend %s", hc.BackendName)
 			}
+			// user keyed limits need the authenticated user, others are checked before auth
+			for _, rl := range hc.ClientRateLimit {
+				if rl.Key == "user" {
+					h, err = newClientRateLimitWrapper(rl, true, h)
+					if err != nil {
+						return nil, fmt.Errorf("handler %s: %s", hc.Path, err)
+					}
+				}
+			}
 			if hc.Auth != nil {
-				h, err = AuthWrapper(hc.Auth, h)
+				h, err = AuthWrapper(hc.Auth, h, backends)
 				if err != nil {
 					return nil, err
 				}
 			}
+			for _, rl := range hc.ClientRateLimit {
+				if rl.Key != "user" {
+					h, err = newClientRateLimitWrapper(rl, false, h)
+					if err != nil {
+						return nil, fmt.Errorf("handler %s: %s", hc.Path, err)
+					}
+				}
+			}
+			var hacl *ipacl.ACL
+			if hc.Acl != nil {
+				hacl, err = newACL(hc.Acl)
+				if err != nil {
+					return nil, fmt.Errorf("handler %s: %s", hc.Path, err)
+				}
+				h = &aclWrapper{ACL: hacl, Handler: h}
+			}
+			hrl, err := newRateLimiter(vname+" handler "+hc.Path, hc.ClusterRate, hc.RateAlgorithm, hc.Maxrate, hc.RateBurst)
+			if err != nil {
+				return nil, fmt.Errorf("handler %s: %s", hc.Path, err)
+			}
 			ch := &stats.CountersCollectingHandler{
 				Handler:     h,
-				RateLimiter: stats.NewRateLimiter(hc.Maxrate),
-				Limiter:     stats.NewLimiter(int(hc.Maxconn)),
+				RateLimiter: hrl,
+				Limiter:     stats.NewQueueLimiter(int(hc.Maxconn), int(hc.Maxqueue), time.Duration(hc.QueueTimeoutMs)*time.Millisecond),
+				Priority:    int(hc.Priority),
 			}
 			mux.Handle(hc.Path, ch)
-			r := &Route{Cf: hc, Counting: ch, RateLimiter: ch.RateLimiter, Limiter: ch.Limiter}
+			r := &Route{Cf: hc, Counting: ch, RateLimiter: ch.RateLimiter, Limiter: ch.Limiter, ACL: hacl}
 			vhost.Routes = append(vhost.Routes, r)
 		}
 	}
@@ -166,6 +255,8 @@ func NewFrontend(cf *config.HttpFrontend, backends HandlersMap) (*Frontend, erro
 		Handler:      f,
 		ReadTimeout:  30 * time.Second,
 		WriteTimeout: 30 * time.Second,
+		// client address for backends sending PROXY headers
+		ConnContext: proxyproto.NewConnContext,
 	}
 	//TODO: handle error (raised if l.Accept errors)
 	if len(f.Cf.SslCert) != 0 || f.Cf.SslCertMask != "" {
@@ -189,6 +280,18 @@ func NewFrontend(cf *config.HttpFrontend, backends HandlersMap) (*Frontend, erro
 		}
 		f.tlsconf.BuildNameToCertificate()
 		glog.V(1).Infof("configured TLS certificates: %v", f.tlsconf.NameToCertificate)
+		if f.Cf.OcspStapling {
+			f.stapler, err = NewOCSPStapler(f.tlsconf.Certificates, f.Cf.OcspCacheDir)
+			if err != nil {
+				return nil, err
+			}
+			f.tlsconf.GetCertificate = f.stapler.GetCertificate
+			// crypto/tls consults GetCertificate only for clients sending SNI while
+			// Certificates is populated, so let the stapler select all certificates
+			f.tlsconf.Certificates = nil
+			f.tlsconf.NameToCertificate = nil
+			f.stapler.Run()
+		}
 		f.srv.TLSConfig = f.tlsconf
 		http2.ConfigureServer(f.srv, nil)
 		f.tlsconf.NextProtos = append(f.tlsconf.NextProtos, "http/1.1")
@@ -204,6 +307,15 @@ func (f *Frontend) Listen() error {
 			return err
 		}
 		f.Sln = NewStoppableListener(ln.(*net.TCPListener), f.Cf.MaxConnRate, f.Cf.MaxConns)
+		f.Sln.RateLimiter, err = stats.NewRateLimiterAlgorithm(f.Cf.ConnRateAlgorithm, f.Cf.MaxConnRate, int(f.Cf.ConnRateBurst))
+		if err != nil {
+			return err
+		}
+		if f.Cf.AclOnAccept {
+			f.Sln.ACL = f.ACL
+		}
+		f.Sln.ProxyProtocolFrom = f.proxyFrom
+		f.Sln.TrustedProxies = f.clientIp.trusted
 	}
 
 	if f.tlsconf != nil {
@@ -220,6 +332,15 @@ func (f *Frontend) Listen() error {
 
 		//TODO: put it in the struct so it could be actually stopped
 		sln := NewStoppableListener(ln.(*net.TCPListener), f.Cf.SslMaxConnRate, f.Cf.SslMaxConns)
+		sln.RateLimiter, err = stats.NewRateLimiterAlgorithm(f.Cf.ConnRateAlgorithm, f.Cf.SslMaxConnRate, int(f.Cf.ConnRateBurst))
+		if err != nil {
+			return err
+		}
+		if f.Cf.AclOnAccept {
+			sln.ACL = f.ACL
+		}
+		sln.ProxyProtocolFrom = f.proxyFrom
+		sln.TrustedProxies = f.clientIp.trusted
 		f.TlsSln = sln
 		f.tlsListener = tls.NewListener(sln, f.tlsconf)
 
@@ -238,6 +359,9 @@ func (f *Frontend) Serve() {
 
 func (f *Frontend) Stop() {
 	f.Sln.Stop(false)
+	if f.stapler != nil {
+		f.stapler.Stop()
+	}
 }
 
 // We need an object that implements the http.Handler interface.
@@ -1,15 +1,23 @@
 package backplane
 
 import (
+	"bufio"
+	"crypto/tls"
 	"fmt"
+	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 
 	"github.com/apesternikov/backplane/src/config"
+	"github.com/apesternikov/backplane/src/ipacl"
+	"github.com/apesternikov/backplane/src/proxyproto"
 )
 
 func makeMockBackends(t *testing.T) HandlersMap {
@@ -139,3 +147,429 @@ func TestPaths(t *testing.T) {
 		tc.run(t, b, i)
 	}
 }
+
+func TestHttpsRedirectAndHsts(t *testing.T) {
+	b, err := NewFrontend(mustFEFromText(`
+		bind_http: ":80"
+		host: <
+			default: true
+			https_redirect: < port: 8443 code: 308 exempt_path: "/health" >
+			hsts: < max_age: 31536000 include_subdomains: true >
+			handler: <
+				path: "/"
+				backend_name: "be1"
+				>
+			 >
+		`), makeMockBackends(t))
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+
+	testcases := []urlTestCase{
+		urlTestCase{"http://one.com/a/b?c=d", http.StatusPermanentRedirect, ""},
+		urlTestCase{"http://one.com/.well-known/acme-challenge/token", http.StatusOK, "calling backend be1"},
+		urlTestCase{"http://one.com/health", http.StatusOK, "calling backend be1"},
+	}
+	for i, tc := range testcases {
+		tc.run(t, b, i)
+	}
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "http://one.com:80/a/b?c=d", nil)
+	b.ServeHTTP(w, req)
+	if loc := w.Header().Get("Location"); loc != "https://one.com:8443/a/b?c=d" {
+		t.Errorf("Unexpected redirect location %q", loc)
+	}
+	if sts := w.Header().Get("Strict-Transport-Security"); sts != "" {
+		t.Errorf("Unexpected HSTS header on plain http response: %q", sts)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "https://one.com/a/b", nil)
+	req.TLS = &tls.ConnectionState{ServerName: "one.com"}
+	b.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %v received %v", http.StatusOK, w.Code)
+	}
+	if sts := w.Header().Get("Strict-Transport-Security"); sts != "max-age=31536000; includeSubDomains" {
+		t.Errorf("Unexpected HSTS header %q", sts)
+	}
+}
+
+func TestACL(t *testing.T) {
+	b, err := NewFrontend(mustFEFromText(`
+		bind_http: ":80"
+		acl: < deny: "10.0.0.0/8" allow: "10.1.0.0/16" >
+		host: <
+			domain: "one.com"
+			acl: < deny: "10.1.1.0/24" >
+			handler: <
+				path: "/"
+				backend_name: "be1"
+				>
+			handler: <
+				path: "/admin/"
+				backend_name: "be2"
+				acl: < allow: "10.1.2.1" >
+				>
+			 >
+		`), makeMockBackends(t))
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	testcases := []struct {
+		remote, url string
+		code        int
+	}{
+		{"10.1.2.1:1000", "http://one.com/", 200},
+		{"10.1.2.1:1000", "http://one.com/admin/", 200},
+		{"10.1.2.2:1000", "http://one.com/admin/", 403},
+		{"10.1.1.1:1000", "http://one.com/", 403},
+		{"10.2.0.1:1000", "http://one.com/", 403},
+		{"192.168.0.1:1000", "http://one.com/", 403},
+	}
+	for i, tc := range testcases {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", tc.url, nil)
+		req.RemoteAddr = tc.remote
+		b.ServeHTTP(w, req)
+		if w.Code != tc.code {
+			t.Errorf("testcase %d: expected status %d got %d", i+1, tc.code, w.Code)
+		}
+	}
+	if b.ACL.Rejected() != 2 || b.Vhosts[0].ACL.Rejected() != 1 || b.Vhosts[0].Routes[1].ACL.Rejected() != 1 {
+		t.Errorf("unexpected rejection counters %d %d %d", b.ACL.Rejected(), b.Vhosts[0].ACL.Rejected(), b.Vhosts[0].Routes[1].ACL.Rejected())
+	}
+	if _, err := NewFrontend(mustFEFromText(`
+		bind_http: ":80"
+		acl: < deny: "10.0.0.0/33" >
+		`), makeMockBackends(t)); err == nil {
+		t.Error("expected error for invalid prefix")
+	}
+}
+
+func TestACLOnAccept(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sl := NewStoppableListener(ln.(*net.TCPListener), 0, 0)
+	sl.ACL, _ = ipacl.New(nil, []string{"127.0.0.0/8"}, nil, nil)
+	go func() {
+		c, err := sl.Accept()
+		if err == nil {
+			c.Write([]byte("hello"))
+			c.Close()
+		}
+	}()
+	c, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if n, _ := c.Read(make([]byte, 5)); n != 0 {
+		t.Error("expected connection to be closed")
+	}
+	c.Close()
+	if atomic.LoadInt64(&sl.AclRejectedCnt) != 1 {
+		t.Errorf("expected 1 rejected connection got %d", sl.AclRejectedCnt)
+	}
+	sl.Stop(true)
+	// connections from trusted proxies are checked per request against the client address
+	ln, err = net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sl = NewStoppableListener(ln.(*net.TCPListener), 0, 0)
+	sl.ACL, _ = ipacl.New([]string{"192.168.0.0/16"}, nil, nil, nil)
+	sl.TrustedProxies, _ = ipacl.NewSet([]string{"127.0.0.1"})
+	go func() {
+		c, err := sl.Accept()
+		if err == nil {
+			c.Write([]byte("hello"))
+			c.Close()
+		}
+	}()
+	c, err = net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if b, _ := ioutil.ReadAll(c); string(b) != "hello" {
+		t.Errorf("expected connection from trusted proxy to be accepted, got %q", b)
+	}
+	c.Close()
+	sl.Close()
+}
+
+func TestProxyProtocol(t *testing.T) {
+	// upstream server expecting PROXY v1 headers
+	upstream, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer upstream.Close()
+	go http.Serve(proxyprotoListener{upstream}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "client %s", r.RemoteAddr)
+	}))
+	be, err := NewBackend(&config.HttpBackend{
+		Name:              "be1",
+		Server:            []*config.Server{{Address: upstream.Addr().String()}},
+		SendProxyProtocol: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100 && !be.Servers[0].IsHealthy(); i++ {
+		time.Sleep(20 * time.Millisecond)
+	}
+	if !be.Servers[0].IsHealthy() {
+		t.Fatal("upstream server did not become healthy: ", be.Servers[0].HealthStatus())
+	}
+
+	f, err := NewFrontend(mustFEFromText(`
+		bind_http: "127.0.0.1:0"
+		accept_proxy_protocol: true
+		proxy_protocol_from: "127.0.0.0/8"
+		host: <
+			default: true
+			handler: <
+				path: "/"
+				backend_name: "be1"
+				>
+			 >
+		`), func(name string) http.Handler { return be })
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	if err := f.Listen(); err != nil {
+		t.Fatal(err)
+	}
+	go f.Serve()
+	defer f.Stop()
+
+	c, err := net.Dial("tcp", f.Sln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.SetDeadline(time.Now().Add(5 * time.Second))
+	fmt.Fprintf(c, "PROXY TCP4 1.2.3.4 5.6.7.8 1000 80\r\nGET / HTTP/1.0\r\nHost: one.com\r\n\r\n")
+	resp, err := http.ReadResponse(bufio.NewReader(c), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	if string(body) != "client 1.2.3.4:1000" {
+		t.Errorf("unexpected response %q", body)
+	}
+
+	if _, err := NewFrontend(mustFEFromText(`
+		bind_http: ":80"
+		accept_proxy_protocol: true
+		`), makeMockBackends(t)); err == nil {
+		t.Error("expected error without proxy_protocol_from")
+	}
+}
+
+type proxyprotoListener struct {
+	net.Listener
+}
+
+func (l proxyprotoListener) Accept() (net.Conn, error) {
+	c, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return proxyproto.NewConn(c, time.Second), nil
+}
+
+func TestClientRateLimit(t *testing.T) {
+	b, err := NewFrontend(mustFEFromText(`
+		bind_http: ":80"
+		host: <
+			domain: "one.com"
+			handler: <
+				path: "/"
+				backend_name: "be1"
+				client_rate_limit: <
+					rate: 0.001
+					burst: 2
+					override: < key: "10.0.0.9" value: < rate: 0 > >
+					>
+				>
+			handler: <
+				path: "/api/"
+				backend_name: "be2"
+				client_rate_limit: < key: "header" key_name: "X-Api-Key" rate: 0.001 >
+				>
+			handler: <
+				path: "/user/"
+				backend_name: "be3"
+				auth: < http_basic: < userpass: < key: "a" value: "a" > userpass: < key: "b" value: "b" > > >
+				client_rate_limit: < key: "user" rate: 0.001 >
+				>
+			 >
+		`), makeMockBackends(t))
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	testcases := []struct {
+		remote, url, apikey, user string
+		code                      int
+	}{
+		{"10.0.0.1:1000", "http://one.com/", "", "", 200},
+		{"10.0.0.1:1001", "http://one.com/", "", "", 200},
+		{"10.0.0.1:1002", "http://one.com/", "", "", 429},
+		{"10.0.0.2:1000", "http://one.com/", "", "", 200},
+		{"10.0.0.9:1000", "http://one.com/", "", "", 200},
+		{"10.0.0.9:1000", "http://one.com/", "", "", 200},
+		{"10.0.0.9:1000", "http://one.com/", "", "", 200},
+		{"10.0.0.1:1000", "http://one.com/api/", "k1", "", 200},
+		{"10.0.0.2:1000", "http://one.com/api/", "k1", "", 429},
+		{"10.0.0.2:1000", "http://one.com/api/", "k2", "", 200},
+		{"10.0.0.1:1000", "http://one.com/user/", "", "a", 200},
+		{"10.0.0.2:1000", "http://one.com/user/", "", "a", 429},
+		{"10.0.0.1:1000", "http://one.com/user/", "", "b", 200},
+	}
+	for i, tc := range testcases {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", tc.url, nil)
+		req.RemoteAddr = tc.remote
+		if tc.apikey != "" {
+			req.Header.Set("X-Api-Key", tc.apikey)
+		}
+		if tc.user != "" {
+			req.SetBasicAuth(tc.user, tc.user)
+		}
+		b.ServeHTTP(w, req)
+		if w.Code != tc.code {
+			t.Errorf("testcase %d: expected status %d got %d", i+1, tc.code, w.Code)
+		}
+		if w.Code == 429 && w.Header().Get("Retry-After") == "" {
+			t.Errorf("testcase %d: Retry-After is missing", i+1)
+		}
+	}
+	for _, cf := range []string{
+		`host: < client_rate_limit: < key: "user" rate: 1 > >`,
+		`host: < client_rate_limit: < key: "header" rate: 1 > >`,
+		`host: < client_rate_limit: < key: "cookie" rate: 1 > >`,
+		`host: < client_rate_limit: < rate: 0 > >`,
+	} {
+		if _, err := NewFrontend(mustFEFromText(`bind_http: ":80" `+cf), makeMockBackends(t)); err == nil {
+			t.Errorf("expected error for %s", cf)
+		}
+	}
+}
+
+func TestRequestQueueTimeout(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	backends := func(name string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			started <- struct{}{}
+			<-release
+		})
+	}
+	b, err := NewFrontend(mustFEFromText(`
+		bind_http: ":80"
+		host: <
+			default: true
+			handler: <
+				path: "/"
+				backend_name: "be1"
+				maxconn: 1
+				queue_timeout_ms: 20
+				>
+			 >
+		`), backends)
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	go func() {
+		req, _ := http.NewRequest("GET", "http://one.com/", nil)
+		b.ServeHTTP(httptest.NewRecorder(), req)
+	}()
+	<-started
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "http://one.com/", nil)
+	b.ServeHTTP(w, req)
+	close(release)
+	if w.Code != 503 || w.Header().Get("Retry-After") != "1" {
+		t.Errorf("expected 503 with Retry-After, got %d %v", w.Code, w.Header())
+	}
+	if l := b.Vhosts[0].Routes[0].Limiter; l.Dropped() != 1 || l.QueueTimeout() != 20*time.Millisecond {
+		t.Errorf("unexpected limiter state, dropped %d", l.Dropped())
+	}
+}
+
+func TestServerQueueFull(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			started <- struct{}{}
+			<-release
+		}
+	}))
+	defer upstream.Close()
+	be, err := NewBackend(&config.HttpBackend{
+		Name:   "be1",
+		Server: []*config.Server{{Address: upstream.Listener.Addr().String()}},
+		ServerAdaptiveLimit: &config.AdaptiveLimit{
+			MinLimit:       1,
+			MaxLimit:       1,
+			InitialLimit:   1,
+			Maxqueue:       1,
+			QueueTimeoutMs: 2000,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100 && !be.Servers[0].IsHealthy(); i++ {
+		time.Sleep(20 * time.Millisecond)
+	}
+	f, err := NewFrontend(mustFEFromText(`
+		bind_http: ":80"
+		host: <
+			default: true
+			handler: <
+				path: "/"
+				backend_name: "be1"
+				>
+			 >
+		`), func(name string) http.Handler { return be })
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "http://one.com"+path, nil)
+		req.RemoteAddr = "10.0.0.1:1234"
+		f.ServeHTTP(w, req)
+		return w
+	}
+	done := make(chan *httptest.ResponseRecorder, 2)
+	go func() { done <- get("/slow") }()
+	<-started
+	// the only allocation is taken, the next request waits in the queue
+	go func() { done <- get("/queued") }()
+	l := be.Servers[0].Limiter
+	for i := 0; i < 100 && l.Queued() == 0; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	w := get("/rejected")
+	if w.Code != 503 || w.Header().Get("Retry-After") != "2" {
+		t.Errorf("expected 503 with Retry-After for full server queue, got %d %v", w.Code, w.Header())
+	}
+	close(release)
+	for i := 0; i < 2; i++ {
+		if w := <-done; w.Code != 200 {
+			t.Errorf("unexpected status %d", w.Code)
+		}
+	}
+	if l.Dropped() != 1 {
+		t.Errorf("expected 1 dropped request, got %d", l.Dropped())
+	}
+}
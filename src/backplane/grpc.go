@@ -0,0 +1,23 @@
+package backplane
+
+import (
+	"net/http"
+	"strings"
+)
+
+// isGrpc tells if the message is a gRPC call or response
+func isGrpc(h http.Header) bool {
+	return strings.HasPrefix(h.Get("Content-Type"), "application/grpc")
+}
+
+// flushingWriter passes every write to the client immediately, gRPC streams
+// can not wait for the buffer to fill up
+type flushingWriter struct {
+	dst writeFlusher
+}
+
+func (f flushingWriter) Write(p []byte) (int, error) {
+	n, err := f.dst.Write(p)
+	f.dst.Flush()
+	return n, err
+}
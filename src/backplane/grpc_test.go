@@ -0,0 +1,118 @@
+package backplane
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	"github.com/apesternikov/backplane/src/config"
+)
+
+// grpcLikeHandler streams back every line of the request and fails with grpc-status 5 sent
+// in a trailer it does not announce, as gRPC servers do
+func grpcLikeHandler(t *testing.T) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		if r.ProtoMajor != 2 || r.Header.Get("Te") != "trailers" {
+			t.Errorf("unexpected request %s te %q", r.Proto, r.Header.Get("Te"))
+		}
+		w.Header().Set("Content-Type", "application/grpc")
+		w.WriteHeader(200)
+		w.(http.Flusher).Flush()
+		br := bufio.NewReader(r.Body)
+		for {
+			line, err := br.ReadString('\n')
+			if err != nil {
+				break
+			}
+			io.WriteString(w, line)
+			w.(http.Flusher).Flush()
+		}
+		w.Header().Set(http.TrailerPrefix+"Grpc-Status", "5")
+	})
+}
+
+func TestHttp2Backends(t *testing.T) {
+	h2cServer := httptest.NewServer(h2c.NewHandler(grpcLikeHandler(t), &http2.Server{}))
+	defer h2cServer.Close()
+	h2Server := httptest.NewUnstartedServer(grpcLikeHandler(t))
+	h2Server.EnableHTTP2 = true
+	h2Server.StartTLS()
+	defer h2Server.Close()
+
+	for _, tc := range []struct {
+		protocol string
+		server   *httptest.Server
+	}{
+		{"h2c", h2cServer},
+		{"h2", h2Server},
+	} {
+		be, err := NewBackend(&config.HttpBackend{
+			Name:          "be1",
+			Server:        []*config.Server{{Address: tc.server.Listener.Addr().String()}},
+			Protocol:      tc.protocol,
+			TlsSkipVerify: true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 100 && !be.Servers[0].IsHealthy(); i++ {
+			time.Sleep(20 * time.Millisecond)
+		}
+		if !be.Servers[0].IsHealthy() {
+			t.Fatalf("%s: server did not become healthy: %s", tc.protocol, be.Servers[0].HealthStatus())
+		}
+		f, err := NewFrontend(mustFEFromText(`
+			bind_http: ":80"
+			host: <
+				default: true
+				handler: <
+					path: "/"
+					backend_name: "be1"
+					>
+				 >
+			`), func(name string) http.Handler { return be })
+		if err != nil {
+			t.Fatal("Unexpected error: ", err)
+		}
+		front := httptest.NewUnstartedServer(f)
+		front.EnableHTTP2 = true
+		front.StartTLS()
+
+		pr, pw := io.Pipe()
+		req, _ := http.NewRequest("POST", front.URL+"/svc.Echo/Stream", pr)
+		req.Header.Set("Content-Type", "application/grpc")
+		req.Header.Set("Te", "trailers")
+		resp, err := front.Client().Do(req)
+		if err != nil {
+			t.Fatalf("%s: %s", tc.protocol, err)
+		}
+		br := bufio.NewReader(resp.Body)
+		// messages are streamed both ways
+		for _, msg := range []string{"one\n", "two\n"} {
+			io.WriteString(pw, msg)
+			if line, err := br.ReadString('\n'); err != nil || line != msg {
+				t.Errorf("%s: expected %q got %q %v", tc.protocol, msg, line, err)
+			}
+		}
+		pw.Close()
+		rest, _ := io.ReadAll(br)
+		resp.Body.Close()
+		if resp.ProtoMajor != 2 || len(rest) != 0 || resp.Trailer.Get("Grpc-Status") != "5" {
+			t.Errorf("%s: unexpected response %s %q trailers %v", tc.protocol, resp.Proto, rest, resp.Trailer)
+		}
+		if cnt := be.GetCounters(); cnt.GrpcErrors != 1 || cnt.CountersByResponseCode[2] != 1 {
+			t.Errorf("%s: unexpected counters %+v", tc.protocol, cnt)
+		}
+		front.Close()
+	}
+}
@@ -0,0 +1,106 @@
+package backplane
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/apesternikov/backplane/src/config"
+	"github.com/apesternikov/backplane/src/context"
+	"github.com/apesternikov/backplane/src/jwt"
+
+	"github.com/golang/glog"
+)
+
+var NO_JWT_KEYS = errors.New("jwt auth requires key, hmac_secret, jwks_file or jwks_url")
+
+type jwtAuthWrapper struct {
+	Config    *config.AuthJwtT
+	Handler   http.Handler
+	validator *jwt.Validator
+}
+
+func newJwtAuthWrapper(cf *config.AuthJwtT, h http.Handler) (*jwtAuthWrapper, error) {
+	v := &jwt.Validator{
+		Issuers:    cf.Issuer,
+		Audiences:  cf.Audience,
+		Leeway:     time.Duration(cf.LeewaySeconds) * time.Second,
+		RequireExp: !cf.AllowMissingExp,
+	}
+	var static jwt.StaticKeys
+	for _, pem := range cf.Key {
+		keys, err := jwt.ParsePEM([]byte(pem))
+		if err != nil {
+			return nil, err
+		}
+		static = append(static, keys...)
+	}
+	for _, secret := range cf.HmacSecret {
+		static = append(static, jwt.NewHMACKey([]byte(secret)))
+	}
+	if len(static) > 0 {
+		v.Keys = append(v.Keys, static)
+	}
+	if cf.JwksFile != "" {
+		f, err := jwt.OpenJwksFile(cf.JwksFile)
+		if err != nil {
+			return nil, err
+		}
+		v.Keys = append(v.Keys, f)
+	}
+	if cf.JwksUrl != "" {
+		v.Keys = append(v.Keys, jwt.NewJwksURL(cf.JwksUrl, time.Duration(cf.JwksCacheSeconds)*time.Second))
+	}
+	if len(v.Keys) == 0 {
+		return nil, NO_JWT_KEYS
+	}
+	return &jwtAuthWrapper{Config: cf, Handler: h, validator: v}, nil
+}
+
+func (j *jwtAuthWrapper) unauthorized(w http.ResponseWriter, errcode string) {
+	challenge := `Bearer realm="` + j.Config.Realm + `"`
+	if errcode != "" {
+		challenge += `, error="` + errcode + `"`
+	}
+	w.Header().Set("WWW-Authenticate", challenge)
+	w.WriteHeader(401)
+	w.Write([]byte("401 Unauthorized\n"))
+}
+
+func (j *jwtAuthWrapper) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// never pass client supplied values of the forwarded claim headers
+	for _, hdr := range j.Config.ForwardClaim {
+		r.Header.Del(hdr)
+	}
+	authz := r.Header.Get("Authorization")
+	if len(authz) < 7 || !strings.EqualFold(authz[:7], "Bearer ") {
+		j.unauthorized(w, "")
+		return
+	}
+	claims, err := j.validator.Validate(strings.TrimSpace(authz[7:]))
+	if err != nil {
+		glog.V(2).Infof("jwt rejected from %s: %s", requestClientIp(r), err)
+		j.unauthorized(w, "invalid_token")
+		return
+	}
+	for name, value := range j.Config.RequireClaim {
+		if !claims.Matches(name, value) {
+			glog.V(2).Infof("jwt from %s does not match required claim %s", requestClientIp(r), name)
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte("403 Forbidden\n"))
+			return
+		}
+	}
+	if ctx := context.GetRequestContext(r); ctx != nil {
+		if sub, ok := claims["sub"].(string); ok {
+			ctx.Log.User = sub
+		}
+	}
+	for name, hdr := range j.Config.ForwardClaim {
+		if v, ok := claims.String(name); ok {
+			r.Header.Set(hdr, v)
+		}
+	}
+	j.Handler.ServeHTTP(w, r)
+}
@@ -0,0 +1,323 @@
+package backplane
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apesternikov/backplane/src/backoff"
+
+	"github.com/golang/glog"
+	"golang.org/x/crypto/ocsp"
+)
+
+// OCSP responses are fetched for every served certificate, stapled to TLS handshakes
+// and refreshed half way to their NextUpdate. Responses are optionally kept on disk so
+// a restart can staple immediately even if the responder is not reachable.
+
+var (
+	NO_OCSP_SERVER = errors.New("Certificate has no OCSP server")
+	NO_ISSUER_CERT = errors.New("Certificate chain has no issuer certificate")
+)
+
+// retry policy for failed OCSP fetches, ranging from 1 minute up to 1 hour
+var ocspRetryPolicy = backoff.BackoffPolicy{
+	Millis: []int{60000, 120000, 300000, 600000, 1800000, 3600000},
+}
+
+// never refresh more often than that, even if responder returns short-living responses
+var ocspMinRefresh = time.Minute
+
+type stapledCert struct {
+	leaf, issuer *x509.Certificate
+	base         tls.Certificate
+
+	mu         sync.RWMutex
+	current    *tls.Certificate // base with the current staple attached, never modified after publishing
+	nextUpdate time.Time
+}
+
+func (sc *stapledCert) get() *tls.Certificate {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+	return sc.current
+}
+
+// attach the response to the certificate, or remove the staple if der is nil
+func (sc *stapledCert) staple(der []byte, resp *ocsp.Response) {
+	c := sc.base
+	c.OCSPStaple = der
+	sc.mu.Lock()
+	sc.current = &c
+	if resp != nil {
+		sc.nextUpdate = resp.NextUpdate
+	} else {
+		sc.nextUpdate = time.Time{}
+	}
+	sc.mu.Unlock()
+}
+
+// expire the staple if its response is no longer valid
+func (sc *stapledCert) expire(now time.Time) {
+	sc.mu.RLock()
+	expired := !sc.nextUpdate.IsZero() && now.After(sc.nextUpdate)
+	sc.mu.RUnlock()
+	if expired {
+		glog.Errorf("OCSP response for %s expired, removing staple", sc.leaf.Subject.CommonName)
+		sc.staple(nil, nil)
+	}
+}
+
+// shorten delay so the staple is expired in time if it is not refreshed before its NextUpdate
+func (sc *stapledCert) capDelay(delay time.Duration, now time.Time) time.Duration {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+	if sc.nextUpdate.IsZero() {
+		return delay
+	}
+	if left := sc.nextUpdate.Sub(now); left < delay {
+		if left < 0 {
+			return 0
+		}
+		return left
+	}
+	return delay
+}
+
+// OCSPStapler maintains stapled OCSP responses for a set of certificates
+// and selects certificates for TLS handshakes.
+type OCSPStapler struct {
+	CacheDir string // optional
+	Client   *http.Client
+	certs    []*stapledCert
+	byName   map[string]*stapledCert
+	stop     chan struct{}
+}
+
+func NewOCSPStapler(certs []tls.Certificate, cacheDir string) (*OCSPStapler, error) {
+	s := &OCSPStapler{
+		CacheDir: cacheDir,
+		Client:   &http.Client{Timeout: 10 * time.Second},
+		byName:   make(map[string]*stapledCert),
+		stop:     make(chan struct{}),
+	}
+	for _, cert := range certs {
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			return nil, err
+		}
+		sc := &stapledCert{leaf: leaf, base: cert}
+		if len(cert.Certificate) > 1 {
+			sc.issuer, err = x509.ParseCertificate(cert.Certificate[1])
+			if err != nil {
+				return nil, err
+			}
+		}
+		c := cert
+		sc.current = &c
+		s.certs = append(s.certs, sc)
+		names := leaf.DNSNames
+		if len(names) == 0 && leaf.Subject.CommonName != "" {
+			names = []string{leaf.Subject.CommonName}
+		}
+		for _, name := range names {
+			name = strings.ToLower(name)
+			if _, ok := s.byName[name]; !ok {
+				s.byName[name] = sc
+			}
+		}
+	}
+	return s, nil
+}
+
+// GetCertificate selects certificate by SNI the same way crypto/tls does and returns it
+// with the most recent OCSP response stapled. Suitable for tls.Config.GetCertificate
+func (s *OCSPStapler) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if len(s.certs) == 0 {
+		return nil, errors.New("No certificates configured")
+	}
+	name := strings.TrimSuffix(strings.ToLower(hello.ServerName), ".")
+	if sc, ok := s.byName[name]; ok {
+		return sc.get(), nil
+	}
+	// try replacing labels in the name with wildcards until we get a match
+	labels := strings.Split(name, ".")
+	for i := range labels {
+		labels[i] = "*"
+		if sc, ok := s.byName[strings.Join(labels, ".")]; ok {
+			return sc.get(), nil
+		}
+	}
+	return s.certs[0].get(), nil
+}
+
+// Run loads cached responses and starts refreshing all certificates in background
+func (s *OCSPStapler) Run() {
+	for _, sc := range s.certs {
+		if err := s.checkStaplable(sc); err != nil {
+			glog.Warningf("Not stapling OCSP for %s: %s", sc.leaf.Subject.CommonName, err)
+			continue
+		}
+		s.loadCache(sc)
+		go s.refreshLoop(sc)
+	}
+}
+
+func (s *OCSPStapler) Stop() {
+	close(s.stop)
+}
+
+func (s *OCSPStapler) checkStaplable(sc *stapledCert) error {
+	if len(sc.leaf.OCSPServer) == 0 {
+		return NO_OCSP_SERVER
+	}
+	if sc.issuer == nil {
+		return NO_ISSUER_CERT
+	}
+	return nil
+}
+
+func (s *OCSPStapler) refreshLoop(sc *stapledCert) {
+	failures := 0
+	for {
+		var delay time.Duration
+		next, err := s.refresh(sc)
+		if err != nil {
+			glog.Errorf("Unable to refresh OCSP response for %s: %s", sc.leaf.Subject.CommonName, err)
+			delay = ocspRetryPolicy.Duration(failures)
+			failures++
+		} else {
+			delay = next.Sub(time.Now())
+			failures = 0
+		}
+		if delay < ocspMinRefresh {
+			delay = ocspMinRefresh
+		}
+		delay = sc.capDelay(delay, time.Now())
+		t := time.NewTimer(delay)
+		select {
+		case <-s.stop:
+			t.Stop()
+			return
+		case <-t.C:
+		}
+		sc.expire(time.Now())
+	}
+}
+
+// refresh fetches a new response for the certificate and returns time of the next refresh
+func (s *OCSPStapler) refresh(sc *stapledCert) (next time.Time, err error) {
+	der, resp, err := s.fetch(sc)
+	if err != nil {
+		return
+	}
+	if resp.Status == ocsp.Unknown {
+		return next, fmt.Errorf("responder does not know the certificate")
+	}
+	glog.V(1).Infof("OCSP status for %s: %s", sc.leaf.Subject.CommonName, ocspStatusString(resp))
+	sc.staple(der, resp)
+	s.saveCache(sc, der)
+	return refreshTime(resp), nil
+}
+
+func (s *OCSPStapler) fetch(sc *stapledCert) ([]byte, *ocsp.Response, error) {
+	req, err := ocsp.CreateRequest(sc.leaf, sc.issuer, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	url := sc.leaf.OCSPServer[0]
+	glog.V(2).Infof("Fetching OCSP response for %s from %s", sc.leaf.Subject.CommonName, url)
+	hr, err := s.Client.Post(url, "application/ocsp-request", bytes.NewReader(req))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer hr.Body.Close()
+	if hr.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("OCSP responder %s returned %s", url, hr.Status)
+	}
+	der, err := ioutil.ReadAll(io.LimitReader(hr.Body, 1<<20))
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := ocsp.ParseResponseForCert(der, sc.leaf, sc.issuer)
+	if err != nil {
+		return nil, nil, err
+	}
+	return der, resp, nil
+}
+
+// refresh half way between ThisUpdate and NextUpdate
+func refreshTime(resp *ocsp.Response) time.Time {
+	if resp.NextUpdate.IsZero() {
+		return resp.ThisUpdate.Add(time.Hour)
+	}
+	return resp.ThisUpdate.Add(resp.NextUpdate.Sub(resp.ThisUpdate) / 2)
+}
+
+func ocspStatusString(resp *ocsp.Response) string {
+	switch resp.Status {
+	case ocsp.Good:
+		return "good"
+	case ocsp.Revoked:
+		return "revoked"
+	default:
+		return "unknown"
+	}
+}
+
+func (s *OCSPStapler) cacheFile(sc *stapledCert) string {
+	h := sha256.Sum256(sc.leaf.Raw)
+	return filepath.Join(s.CacheDir, hex.EncodeToString(h[:])+".ocsp")
+}
+
+func (s *OCSPStapler) loadCache(sc *stapledCert) {
+	if s.CacheDir == "" {
+		return
+	}
+	fn := s.cacheFile(sc)
+	der, err := ioutil.ReadFile(fn)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			glog.Errorf("Unable to read cached OCSP response %s: %s", fn, err)
+		}
+		return
+	}
+	resp, err := ocsp.ParseResponseForCert(der, sc.leaf, sc.issuer)
+	if err != nil {
+		glog.Errorf("Ignoring bad cached OCSP response %s: %s", fn, err)
+		return
+	}
+	if !resp.NextUpdate.IsZero() && time.Now().After(resp.NextUpdate) {
+		glog.V(1).Infof("Ignoring expired cached OCSP response %s", fn)
+		return
+	}
+	glog.V(1).Infof("Using cached OCSP response %s for %s", fn, sc.leaf.Subject.CommonName)
+	sc.staple(der, resp)
+}
+
+func (s *OCSPStapler) saveCache(sc *stapledCert, der []byte) {
+	if s.CacheDir == "" {
+		return
+	}
+	fn := s.cacheFile(sc)
+	tmp := fn + ".tmp"
+	err := ioutil.WriteFile(tmp, der, 0644)
+	if err == nil {
+		err = os.Rename(tmp, fn)
+	}
+	if err != nil {
+		glog.Errorf("Unable to cache OCSP response in %s: %s", fn, err)
+	}
+}
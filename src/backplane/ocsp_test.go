@@ -0,0 +1,220 @@
+package backplane
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+)
+
+type testPKI struct {
+	ca        *x509.Certificate
+	caKey     crypto.Signer
+	responder *httptest.Server
+	requests  int
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	p := &testPKI{}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, tpl, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.ca, _ = x509.ParseCertificate(der)
+	p.caKey = key
+	p.responder = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p.requests++
+		body, _ := ioutil.ReadAll(r.Body)
+		req, err := ocsp.ParseRequest(body)
+		if err != nil {
+			t.Errorf("bad OCSP request: %s", err)
+			w.WriteHeader(400)
+			return
+		}
+		resp, err := ocsp.CreateResponse(p.ca, p.ca, ocsp.Response{
+			Status:       ocsp.Good,
+			SerialNumber: req.SerialNumber,
+			ThisUpdate:   time.Now().Add(-time.Minute),
+			NextUpdate:   time.Now().Add(time.Hour),
+		}, p.caKey)
+		if err != nil {
+			t.Errorf("unable to create OCSP response: %s", err)
+			w.WriteHeader(500)
+			return
+		}
+		w.Header().Set("Content-Type", "application/ocsp-response")
+		w.Write(resp)
+	}))
+	return p
+}
+
+func (p *testPKI) leaf(t *testing.T, name string) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		OCSPServer:   []string{p.responder.URL},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, p.ca, key.Public(), p.caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der, p.ca.Raw}, PrivateKey: key}
+}
+
+func TestOCSPStapling(t *testing.T) {
+	pki := newTestPKI(t)
+	defer pki.responder.Close()
+	cachedir, err := ioutil.TempDir("", "ocsp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(cachedir)
+
+	s, err := NewOCSPStapler([]tls.Certificate{pki.leaf(t, "one.com"), pki.leaf(t, "two.com")}, cachedir)
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	for _, sc := range s.certs {
+		next, err := s.refresh(sc)
+		if err != nil {
+			t.Fatal("Unable to refresh: ", err)
+		}
+		if d := next.Sub(time.Now()); d < 20*time.Minute || d > 40*time.Minute {
+			t.Errorf("Unexpected refresh time in %s", d)
+		}
+	}
+	if pki.requests != 2 {
+		t.Errorf("Expected 2 requests to responder, got %d", pki.requests)
+	}
+	cert, err := s.GetCertificate(&tls.ClientHelloInfo{ServerName: "two.com"})
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	if !bytes.Equal(cert.Certificate[0], s.certs[1].leaf.Raw) {
+		t.Error("Wrong certificate selected for two.com")
+	}
+	if len(cert.OCSPStaple) == 0 {
+		t.Fatal("Certificate has no OCSP staple")
+	}
+
+	// restart with the responder down, staples should come from the cache
+	pki.responder.Close()
+	s2, err := NewOCSPStapler([]tls.Certificate{s.certs[0].base, s.certs[1].base}, cachedir)
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	for i, sc := range s2.certs {
+		s2.loadCache(sc)
+		if !bytes.Equal(sc.get().OCSPStaple, s.certs[i].get().OCSPStaple) {
+			t.Errorf("cert %d: cached staple does not match", i+1)
+		}
+	}
+}
+
+func TestOCSPNotStaplable(t *testing.T) {
+	certs, err := LoadCertsByMask("testdata/certpem*.pem")
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	s, err := NewOCSPStapler(certs, "")
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	s.Run()
+	defer s.Stop()
+	cert, err := s.GetCertificate(&tls.ClientHelloInfo{ServerName: "nosuchname"})
+	if err != nil || cert == nil {
+		t.Fatal("Expected default certificate, got ", cert, err)
+	}
+	if len(cert.OCSPStaple) != 0 {
+		t.Error("Unexpected staple")
+	}
+}
+
+func TestOCSPExpire(t *testing.T) {
+	pki := newTestPKI(t)
+	defer pki.responder.Close()
+	s, err := NewOCSPStapler([]tls.Certificate{pki.leaf(t, "one.com")}, "")
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	sc := s.certs[0]
+	if _, err := s.refresh(sc); err != nil {
+		t.Fatal("Unable to refresh: ", err)
+	}
+	now := time.Now()
+	if d := sc.capDelay(3*time.Hour, now); d > time.Hour {
+		t.Errorf("Retry delay %s is past the staple expiration", d)
+	}
+	if d := sc.capDelay(time.Minute, now); d != time.Minute {
+		t.Errorf("Expected retry delay to stay 1m, got %s", d)
+	}
+	if d := sc.capDelay(time.Minute, now.Add(2*time.Hour)); d != 0 {
+		t.Errorf("Expected no delay for expired staple, got %s", d)
+	}
+	sc.expire(time.Now())
+	if len(sc.get().OCSPStaple) == 0 {
+		t.Error("Valid staple removed")
+	}
+	sc.expire(time.Now().Add(2 * time.Hour))
+	if len(sc.get().OCSPStaple) != 0 {
+		t.Error("Expired staple is still attached")
+	}
+}
+
+func TestOCSPStapleWithoutSNI(t *testing.T) {
+	pki := newTestPKI(t)
+	defer pki.responder.Close()
+	s, err := NewOCSPStapler([]tls.Certificate{pki.leaf(t, "one.com")}, "")
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	if _, err := s.refresh(s.certs[0]); err != nil {
+		t.Fatal("Unable to refresh: ", err)
+	}
+	sconn, cconn := net.Pipe()
+	defer sconn.Close()
+	go tls.Server(sconn, &tls.Config{GetCertificate: s.GetCertificate}).Handshake()
+	c := tls.Client(cconn, &tls.Config{InsecureSkipVerify: true})
+	defer c.Close()
+	if err := c.Handshake(); err != nil {
+		t.Fatal("Handshake failed: ", err)
+	}
+	if len(c.ConnectionState().OCSPResponse) == 0 {
+		t.Error("No OCSP staple for client without SNI")
+	}
+}
@@ -8,20 +8,44 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/apesternikov/bindata"
 	"golang.org/x/net/trace"
 
 	"github.com/apesternikov/backplane/src/backplane/static/tpls"
 
 	"github.com/apesternikov/backplane/src/config"
+	"github.com/apesternikov/backplane/src/swim"
 	"github.com/golang/glog"
 )
 
 type Backplane struct {
 	Backends  []*Backend
 	Frontends []*Frontend
+	Cluster   Membership      // source of cluster_servers of backends
+	swim      *swim.Swim      // membership protocol started with cluster config
+	clusterCf *config.Cluster // cluster config swim was started with
+	stopRates chan struct{}
 }
 
 func (bp *Backplane) Configure(cf *config.Config) error {
+	// the node keeps its membership across reconfigurations, only its metadata changes
+	if cf.Cluster != nil && bp.swim == nil {
+		if err := bp.joinCluster(cf.Cluster, clusterMeta(cf)); err != nil {
+			return err
+		}
+	} else if cf.Cluster != nil {
+		if clusterChanged(bp.clusterCf, cf.Cluster) {
+			glog.Warning("Cluster config changed, only tags are applied until restart")
+		}
+		if err := bp.swim.SetMeta(clusterMeta(cf)); err != nil {
+			glog.Errorf("Unable to update cluster metadata: %s", err)
+		}
+	} else if bp.swim != nil {
+		glog.Warning("Cluster config removed, staying in the cluster until restart")
+	}
+	// cluster rate limits removed from the config are not shared any more
+	clusterRates.Mark()
+	defer clusterRates.Sweep()
 	backends := make(map[string]http.Handler)
 	Backends := make([]*Backend, 0, len(cf.HttpBackend)+1)
 	for _, cf := range cf.HttpBackend {
@@ -30,11 +54,19 @@ func (bp *Backplane) Configure(cf *config.Config) error {
 			glog.Errorf("Unable to create new backend %s: %s", cf.Name, err)
 			continue
 		}
+		if cf.ClusterServers != nil {
+			if bp.Cluster != nil {
+				newb.DiscoverServers(bp.Cluster)
+			} else {
+				glog.Errorf("backend %s: cluster_servers require cluster membership", cf.Name)
+			}
+		}
 		backends[cf.Name] = newb
 		Backends = append(Backends, newb)
 	}
 
 	backends["internalstats"] = http.HandlerFunc(bp.handleStats)
+	backends["internalcluster"] = http.HandlerFunc(bp.handleCluster)
 
 	frontends := make([]*Frontend, 0, len(cf.HttpFrontend))
 	for _, cf := range cf.HttpFrontend {
@@ -64,27 +96,37 @@ var funcMap = template.FuncMap{
 }
 
 var (
-	statstpl *template.Template
-	tplmux   sync.Mutex
+	statstpl   *template.Template
+	clustertpl *template.Template
+	tplmux     sync.Mutex
 )
 
 func StatsTemplate() *template.Template {
+	return loadTemplate(tpls.Stats_html, &statstpl)
+}
+
+func ClusterTemplate() *template.Template {
+	return loadTemplate(tpls.Cluster_html, &clustertpl)
+}
+
+// parse the template into tpl unless already parsed and not changed
+func loadTemplate(b *bindata.Bindata, tpl **template.Template) *template.Template {
 	tplmux.Lock()
 	defer tplmux.Unlock()
-	changed, err := tpls.Stats_html.Refresh()
+	changed, err := b.Refresh()
 	if err != nil {
 		panic(err) //only possible in dev mode
 	}
 	//TODO: race condition
-	if statstpl == nil || changed {
-		t, err := template.New(tpls.Stats_html.Filename).Funcs(funcMap).Parse(string(tpls.Stats_html.Data))
+	if *tpl == nil || changed {
+		t, err := template.New(b.Filename).Funcs(funcMap).Parse(string(b.Data))
 		if err != nil {
-			glog.Errorf("Error parsing template %s: %s", tpls.Stats_html.Filename, err)
-			return statstpl //return old value
+			glog.Errorf("Error parsing template %s: %s", b.Filename, err)
+			return *tpl //return old value
 		}
-		statstpl = t
+		*tpl = t
 	}
-	return statstpl
+	return *tpl
 }
 
 var starttime time.Time
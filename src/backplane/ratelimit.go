@@ -0,0 +1,74 @@
+package backplane
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/apesternikov/backplane/src/backplane/stats"
+	"github.com/apesternikov/backplane/src/config"
+	"github.com/apesternikov/backplane/src/context"
+
+	"github.com/golang/glog"
+)
+
+// clientRateLimitWrapper limits request rate of every client separately.
+// Clients are told apart by ip, a header, a query parameter (like an API key) or the authenticated user.
+type clientRateLimitWrapper struct {
+	Config  *config.RateLimit
+	Limiter *stats.KeyedRateLimiter
+	Handler http.Handler
+}
+
+func newClientRateLimitWrapper(cf *config.RateLimit, allowUser bool, h http.Handler) (*clientRateLimitWrapper, error) {
+	switch cf.Key {
+	case "", "ip":
+	case "header", "query":
+		if cf.KeyName == "" {
+			return nil, fmt.Errorf("rate limit by %s requires key_name", cf.Key)
+		}
+	case "user":
+		if !allowUser {
+			return nil, fmt.Errorf("rate limit by user is only supported on handlers")
+		}
+	default:
+		return nil, fmt.Errorf("unknown rate limit key %s", cf.Key)
+	}
+	if cf.Rate <= 0 {
+		return nil, fmt.Errorf("rate limit by %s: rate must be positive", cf.Key)
+	}
+	l := stats.NewKeyedRateLimiter(cf.Rate, int(cf.Burst), int(cf.MaxKeys))
+	for key, o := range cf.Override {
+		l.SetOverride(key, o.Rate, int(o.Burst))
+	}
+	return &clientRateLimitWrapper{Config: cf, Limiter: l, Handler: h}, nil
+}
+
+func (l *clientRateLimitWrapper) key(r *http.Request) string {
+	switch l.Config.Key {
+	case "header":
+		return r.Header.Get(l.Config.KeyName)
+	case "query":
+		return r.URL.Query().Get(l.Config.KeyName)
+	case "user":
+		if ctx := context.GetRequestContext(r); ctx != nil {
+			return ctx.Log.User
+		}
+		return ""
+	}
+	return requestClientIp(r)
+}
+
+func (l *clientRateLimitWrapper) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := l.key(r)
+	if ok, wait := l.Limiter.Accept(key); !ok {
+		glog.V(2).Infof("rate limited %s %s, %s %q", r.Method, r.RequestURI, l.Config.Key, key)
+		// round up, clients retrying after 0 seconds would be rejected again
+		w.Header().Set("Retry-After", strconv.Itoa(int((wait+time.Second-1)/time.Second)))
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte("429 Too Many Requests\n"))
+		return
+	}
+	l.Handler.ServeHTTP(w, r)
+}
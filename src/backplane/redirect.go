@@ -0,0 +1,75 @@
+package backplane
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/apesternikov/backplane/src/config"
+)
+
+// ACME http-01 challenges must be answered over plain http
+const acmeChallengePath = "/.well-known/acme-challenge/"
+
+// httpsRedirectWrapper redirects requests received over plain http to the same URL over https
+type httpsRedirectWrapper struct {
+	Config  *config.HttpsRedirect
+	Handler http.Handler
+}
+
+func (h *httpsRedirectWrapper) exempt(path string) bool {
+	if strings.HasPrefix(path, acmeChallengePath) {
+		return true
+	}
+	for _, prefix := range h.Config.ExemptPath {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *httpsRedirectWrapper) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.TLS != nil || h.exempt(r.URL.Path) {
+		h.Handler.ServeHTTP(w, r)
+		return
+	}
+	host := r.Host
+	if hostonly, _, err := net.SplitHostPort(host); err == nil {
+		host = hostonly
+	}
+	if h.Config.Port != 0 && h.Config.Port != 443 {
+		host = net.JoinHostPort(strings.Trim(host, "[]"), strconv.FormatInt(h.Config.Port, 10))
+	}
+	code := http.StatusMovedPermanently
+	if h.Config.Code != 0 {
+		code = int(h.Config.Code)
+	}
+	http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), code)
+}
+
+// hstsWrapper adds Strict-Transport-Security header to responses sent over https
+type hstsWrapper struct {
+	value   string
+	Handler http.Handler
+}
+
+func newHstsWrapper(cf *config.Hsts, h http.Handler) *hstsWrapper {
+	value := fmt.Sprintf("max-age=%d", cf.MaxAge)
+	if cf.IncludeSubdomains {
+		value += "; includeSubDomains"
+	}
+	if cf.Preload {
+		value += "; preload"
+	}
+	return &hstsWrapper{value: value, Handler: h}
+}
+
+func (h *hstsWrapper) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.TLS != nil {
+		w.Header().Set("Strict-Transport-Security", h.value)
+	}
+	h.Handler.ServeHTTP(w, r)
+}
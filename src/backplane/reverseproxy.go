@@ -5,19 +5,25 @@
 // This is a copy of the system reverse proxy with local modifications:
 // 1. context is propagated to the RoundTripper
 // 2. we do not use system transport/roundtripper to aviod misuse
+// 3. protocol upgrades (WebSocket) are passed to the server and spliced, see upgrade.go
+// 4. trailers not announced by the server and gRPC streams are passed to the client, see grpc.go
+// 5. requests not let through the server limiter queue get 503 with Retry-After
 
 package backplane
 
 import (
+	"errors"
 	"io"
 	"log"
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/apesternikov/backplane/src/backplane/stats"
 	"github.com/apesternikov/backplane/src/context"
 
 	"github.com/golang/glog"
@@ -52,6 +58,14 @@ type ReverseProxy struct {
 	// If nil, logging goes to os.Stderr via the log package's
 	// standard logger.
 	ErrorLog *log.Logger
+
+	// UpgradeIdleTimeout closes upgraded connections after this
+	// long without data in either direction.
+	// If zero, defaultUpgradeIdleTimeout is used.
+	UpgradeIdleTimeout time.Duration
+
+	// Upgrades counts upgraded connections, optional.
+	Upgrades *UpgradeCounters
 }
 
 func singleJoiningSlash(a, b string) string {
@@ -164,6 +178,7 @@ func (p *ReverseProxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	}
 
 	p.Director(outreq)
+	reqUpType := upgradeType(outreq.Header)
 	outreq.Proto = "HTTP/1.1"
 	outreq.ProtoMajor = 1
 	outreq.ProtoMinor = 1
@@ -185,6 +200,15 @@ func (p *ReverseProxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 			outreq.Header.Del(h)
 		}
 	}
+	if reqUpType != "" {
+		// the only hop-by-hop headers passed to the server
+		outreq.Header.Set("Connection", "Upgrade")
+		outreq.Header.Set("Upgrade", req.Header.Get("Upgrade"))
+	}
+	// gRPC servers require the client to accept trailers
+	if hasToken(req.Header["Te"], "trailers") {
+		outreq.Header.Set("Te", "trailers")
+	}
 
 	if clientIP, _, err := net.SplitHostPort(req.RemoteAddr); err == nil {
 		// If we aren't the first proxy retain prior
@@ -203,10 +227,22 @@ func (p *ReverseProxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		if ctx != nil && ctx.Tr != nil {
 			ctx.Tr.LazyPrintf("http: proxy error: %v", err)
 		}
+		// the server is busy, the client may retry like when rejected by frontend limiters
+		var qe *stats.QueueError
+		if errors.As(err, &qe) {
+			rw.Header().Set("Retry-After", strconv.Itoa(stats.RetryAfter(qe.Limiter)))
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
 		rw.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
+	if res.StatusCode == http.StatusSwitchingProtocols {
+		p.serveUpgrade(rw, req, res, reqUpType)
+		return
+	}
+
 	for _, h := range hopHeaders {
 		res.Header.Del(h)
 	}
@@ -215,7 +251,8 @@ func (p *ReverseProxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 
 	// The "Trailer" header isn't included in the Transport's response,
 	// at least for *http.Transport. Build it up from Trailer.
-	if len(res.Trailer) > 0 {
+	announcedTrailers := len(res.Trailer)
+	if announcedTrailers > 0 {
 		var trailerKeys []string
 		for k := range res.Trailer {
 			trailerKeys = append(trailerKeys, k)
@@ -224,21 +261,36 @@ func (p *ReverseProxy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	}
 
 	rw.WriteHeader(res.StatusCode)
-	if len(res.Trailer) > 0 {
+	grpc := isGrpc(res.Header)
+	if len(res.Trailer) > 0 || grpc {
 		// Force chunking if we saw a response trailer.
 		// This prevents net/http from calculating the length for short
 		// bodies and adding a Content-Length.
+		// gRPC clients may wait for headers before sending stream messages.
 		if fl, ok := rw.(http.Flusher); ok {
 			fl.Flush()
 		}
 	}
-	p.copyResponse(rw, res.Body)
+	p.copyResponse(rw, res.Body, grpc)
 	res.Body.Close() // close now, instead of defer, to populate res.Trailer
-	copyHeader(rw.Header(), res.Trailer)
+	if len(res.Trailer) == announcedTrailers {
+		copyHeader(rw.Header(), res.Trailer)
+		return
+	}
+	// trailers the server did not announce, like grpc-status
+	for k, vv := range res.Trailer {
+		for _, v := range vv {
+			rw.Header().Add(http.TrailerPrefix+k, v)
+		}
+	}
 }
 
-func (p *ReverseProxy) copyResponse(dst io.Writer, src io.Reader) {
-	if p.FlushInterval != 0 {
+func (p *ReverseProxy) copyResponse(dst io.Writer, src io.Reader, flushEveryWrite bool) {
+	if flushEveryWrite {
+		if wf, ok := dst.(writeFlusher); ok {
+			dst = flushingWriter{wf}
+		}
+	} else if p.FlushInterval != 0 {
 		if wf, ok := dst.(writeFlusher); ok {
 			mlw := &maxLatencyWriter{
 				dst:     wf,
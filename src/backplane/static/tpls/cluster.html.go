@@ -0,0 +1,212 @@
+package tpls
+//This file has been generated by bindata, DO NOT EDIT!
+import "github.com/apesternikov/bindata"
+import "time"
+
+var Cluster_html = &bindata.Bindata{ []byte{
+	
+0x3c,0x21,0x44,0x4f,0x43,0x54,0x59,0x50,0x45,0x20,0x68,0x74,
+0x6d,0x6c,0x3e,0x0a,0x3c,0x68,0x74,0x6d,0x6c,0x3e,0x0a,0x3c,
+0x68,0x65,0x61,0x64,0x3e,0x0a,0x09,0x3c,0x74,0x69,0x74,0x6c,
+0x65,0x3e,0x43,0x6c,0x75,0x73,0x74,0x65,0x72,0x20,0x52,0x65,
+0x70,0x6f,0x72,0x74,0x20,0x66,0x6f,0x72,0x20,0x42,0x61,0x63,
+0x6b,0x70,0x6c,0x61,0x6e,0x65,0x3c,0x2f,0x74,0x69,0x74,0x6c,
+0x65,0x3e,0x0a,0x09,0x3c,0x6d,0x65,0x74,0x61,0x20,0x68,0x74,
+0x74,0x70,0x2d,0x65,0x71,0x75,0x69,0x76,0x3d,0x22,0x63,0x6f,
+0x6e,0x74,0x65,0x6e,0x74,0x2d,0x74,0x79,0x70,0x65,0x22,0x20,
+0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x3d,0x22,0x74,0x65,0x78,
+0x74,0x2f,0x68,0x74,0x6d,0x6c,0x3b,0x20,0x63,0x68,0x61,0x72,
+0x73,0x65,0x74,0x3d,0x69,0x73,0x6f,0x2d,0x38,0x38,0x35,0x39,
+0x2d,0x31,0x22,0x3e,0x0a,0x09,0x3c,0x73,0x74,0x79,0x6c,0x65,
+0x20,0x74,0x79,0x70,0x65,0x3d,0x22,0x74,0x65,0x78,0x74,0x2f,
+0x63,0x73,0x73,0x22,0x3e,0x3c,0x21,0x2d,0x2d,0x0a,0x09,0x62,
+0x6f,0x64,0x79,0x20,0x7b,0x20,0x66,0x6f,0x6e,0x74,0x2d,0x66,
+0x61,0x6d,0x69,0x6c,0x79,0x3a,0x20,0x61,0x72,0x69,0x61,0x6c,
+0x2c,0x20,0x68,0x65,0x6c,0x76,0x65,0x74,0x69,0x63,0x61,0x2c,
+0x20,0x73,0x61,0x6e,0x73,0x2d,0x73,0x65,0x72,0x69,0x66,0x3b,
+0x20,0x66,0x6f,0x6e,0x74,0x2d,0x73,0x69,0x7a,0x65,0x3a,0x20,
+0x31,0x32,0x70,0x78,0x3b,0x20,0x66,0x6f,0x6e,0x74,0x2d,0x77,
+0x65,0x69,0x67,0x68,0x74,0x3a,0x20,0x6e,0x6f,0x72,0x6d,0x61,
+0x6c,0x3b,0x20,0x63,0x6f,0x6c,0x6f,0x72,0x3a,0x20,0x62,0x6c,
+0x61,0x63,0x6b,0x3b,0x20,0x62,0x61,0x63,0x6b,0x67,0x72,0x6f,
+0x75,0x6e,0x64,0x3a,0x20,0x77,0x68,0x69,0x74,0x65,0x3b,0x7d,
+0x0a,0x09,0x74,0x68,0x2c,0x74,0x64,0x20,0x7b,0x20,0x66,0x6f,
+0x6e,0x74,0x2d,0x73,0x69,0x7a,0x65,0x3a,0x20,0x31,0x30,0x70,
+0x78,0x3b,0x7d,0x0a,0x09,0x68,0x31,0x20,0x7b,0x20,0x66,0x6f,
+0x6e,0x74,0x2d,0x73,0x69,0x7a,0x65,0x3a,0x20,0x78,0x2d,0x6c,
+0x61,0x72,0x67,0x65,0x3b,0x20,0x6d,0x61,0x72,0x67,0x69,0x6e,
+0x2d,0x62,0x6f,0x74,0x74,0x6f,0x6d,0x3a,0x20,0x30,0x2e,0x35,
+0x65,0x6d,0x3b,0x7d,0x0a,0x09,0x68,0x32,0x20,0x7b,0x20,0x66,
+0x6f,0x6e,0x74,0x2d,0x66,0x61,0x6d,0x69,0x6c,0x79,0x3a,0x20,
+0x68,0x65,0x6c,0x76,0x65,0x74,0x69,0x63,0x61,0x2c,0x20,0x61,
+0x72,0x69,0x61,0x6c,0x3b,0x20,0x66,0x6f,0x6e,0x74,0x2d,0x73,
+0x69,0x7a,0x65,0x3a,0x20,0x78,0x2d,0x6c,0x61,0x72,0x67,0x65,
+0x3b,0x20,0x66,0x6f,0x6e,0x74,0x2d,0x77,0x65,0x69,0x67,0x68,
+0x74,0x3a,0x20,0x62,0x6f,0x6c,0x64,0x3b,0x20,0x66,0x6f,0x6e,
+0x74,0x2d,0x73,0x74,0x79,0x6c,0x65,0x3a,0x20,0x69,0x74,0x61,
+0x6c,0x69,0x63,0x3b,0x20,0x63,0x6f,0x6c,0x6f,0x72,0x3a,0x20,
+0x23,0x36,0x30,0x32,0x30,0x61,0x30,0x3b,0x20,0x6d,0x61,0x72,
+0x67,0x69,0x6e,0x2d,0x74,0x6f,0x70,0x3a,0x20,0x30,0x65,0x6d,
+0x3b,0x20,0x6d,0x61,0x72,0x67,0x69,0x6e,0x2d,0x62,0x6f,0x74,
+0x74,0x6f,0x6d,0x3a,0x20,0x30,0x65,0x6d,0x3b,0x7d,0x0a,0x09,
+0x68,0x33,0x20,0x7b,0x20,0x66,0x6f,0x6e,0x74,0x2d,0x66,0x61,
+0x6d,0x69,0x6c,0x79,0x3a,0x20,0x68,0x65,0x6c,0x76,0x65,0x74,
+0x69,0x63,0x61,0x2c,0x20,0x61,0x72,0x69,0x61,0x6c,0x3b,0x20,
+0x66,0x6f,0x6e,0x74,0x2d,0x73,0x69,0x7a,0x65,0x3a,0x20,0x31,
+0x36,0x70,0x78,0x3b,0x20,0x66,0x6f,0x6e,0x74,0x2d,0x77,0x65,
+0x69,0x67,0x68,0x74,0x3a,0x20,0x62,0x6f,0x6c,0x64,0x3b,0x20,
+0x63,0x6f,0x6c,0x6f,0x72,0x3a,0x20,0x23,0x62,0x30,0x30,0x30,
+0x34,0x30,0x3b,0x20,0x62,0x61,0x63,0x6b,0x67,0x72,0x6f,0x75,
+0x6e,0x64,0x3a,0x20,0x23,0x65,0x38,0x65,0x38,0x64,0x30,0x3b,
+0x20,0x6d,0x61,0x72,0x67,0x69,0x6e,0x2d,0x74,0x6f,0x70,0x3a,
+0x20,0x30,0x65,0x6d,0x3b,0x20,0x6d,0x61,0x72,0x67,0x69,0x6e,
+0x2d,0x62,0x6f,0x74,0x74,0x6f,0x6d,0x3a,0x20,0x30,0x65,0x6d,
+0x3b,0x7d,0x0a,0x09,0x2e,0x68,0x72,0x20,0x7b,0x6d,0x61,0x72,
+0x67,0x69,0x6e,0x2d,0x74,0x6f,0x70,0x3a,0x20,0x30,0x2e,0x32,
+0x35,0x65,0x6d,0x3b,0x20,0x62,0x6f,0x72,0x64,0x65,0x72,0x2d,
+0x63,0x6f,0x6c,0x6f,0x72,0x3a,0x20,0x62,0x6c,0x61,0x63,0x6b,
+0x3b,0x20,0x62,0x6f,0x72,0x64,0x65,0x72,0x2d,0x62,0x6f,0x74,
+0x74,0x6f,0x6d,0x2d,0x73,0x74,0x79,0x6c,0x65,0x3a,0x20,0x73,
+0x6f,0x6c,0x69,0x64,0x3b,0x7d,0x0a,0x09,0x2e,0x74,0x69,0x74,
+0x72,0x65,0x09,0x7b,0x62,0x61,0x63,0x6b,0x67,0x72,0x6f,0x75,
+0x6e,0x64,0x3a,0x20,0x23,0x32,0x30,0x44,0x30,0x44,0x30,0x3b,
+0x63,0x6f,0x6c,0x6f,0x72,0x3a,0x20,0x23,0x30,0x30,0x30,0x30,
+0x30,0x30,0x3b,0x20,0x66,0x6f,0x6e,0x74,0x2d,0x77,0x65,0x69,
+0x67,0x68,0x74,0x3a,0x20,0x62,0x6f,0x6c,0x64,0x3b,0x20,0x74,
+0x65,0x78,0x74,0x2d,0x61,0x6c,0x69,0x67,0x6e,0x3a,0x20,0x63,
+0x65,0x6e,0x74,0x65,0x72,0x3b,0x7d,0x0a,0x09,0x2e,0x61,0x63,
+0x74,0x69,0x76,0x65,0x30,0x09,0x7b,0x62,0x61,0x63,0x6b,0x67,
+0x72,0x6f,0x75,0x6e,0x64,0x3a,0x20,0x23,0x66,0x66,0x39,0x30,
+0x39,0x30,0x3b,0x7d,0x0a,0x09,0x2e,0x61,0x63,0x74,0x69,0x76,
+0x65,0x32,0x09,0x7b,0x62,0x61,0x63,0x6b,0x67,0x72,0x6f,0x75,
+0x6e,0x64,0x3a,0x20,0x23,0x66,0x66,0x64,0x30,0x32,0x30,0x3b,
+0x7d,0x0a,0x09,0x2e,0x61,0x63,0x74,0x69,0x76,0x65,0x34,0x09,
+0x7b,0x62,0x61,0x63,0x6b,0x67,0x72,0x6f,0x75,0x6e,0x64,0x3a,
+0x20,0x23,0x63,0x30,0x66,0x66,0x63,0x30,0x3b,0x7d,0x0a,0x09,
+0x74,0x61,0x62,0x6c,0x65,0x2e,0x74,0x62,0x6c,0x20,0x7b,0x20,
+0x62,0x6f,0x72,0x64,0x65,0x72,0x2d,0x63,0x6f,0x6c,0x6c,0x61,
+0x70,0x73,0x65,0x3a,0x20,0x63,0x6f,0x6c,0x6c,0x61,0x70,0x73,
+0x65,0x3b,0x20,0x62,0x6f,0x72,0x64,0x65,0x72,0x2d,0x73,0x74,
+0x79,0x6c,0x65,0x3a,0x20,0x6e,0x6f,0x6e,0x65,0x3b,0x7d,0x0a,
+0x09,0x74,0x61,0x62,0x6c,0x65,0x2e,0x74,0x62,0x6c,0x20,0x74,
+0x64,0x20,0x7b,0x20,0x74,0x65,0x78,0x74,0x2d,0x61,0x6c,0x69,
+0x67,0x6e,0x3a,0x20,0x72,0x69,0x67,0x68,0x74,0x3b,0x20,0x62,
+0x6f,0x72,0x64,0x65,0x72,0x2d,0x77,0x69,0x64,0x74,0x68,0x3a,
+0x20,0x31,0x70,0x78,0x20,0x31,0x70,0x78,0x20,0x31,0x70,0x78,
+0x20,0x31,0x70,0x78,0x3b,0x20,0x62,0x6f,0x72,0x64,0x65,0x72,
+0x2d,0x73,0x74,0x79,0x6c,0x65,0x3a,0x20,0x73,0x6f,0x6c,0x69,
+0x64,0x20,0x73,0x6f,0x6c,0x69,0x64,0x20,0x73,0x6f,0x6c,0x69,
+0x64,0x20,0x73,0x6f,0x6c,0x69,0x64,0x3b,0x20,0x70,0x61,0x64,
+0x64,0x69,0x6e,0x67,0x3a,0x20,0x32,0x70,0x78,0x20,0x33,0x70,
+0x78,0x3b,0x20,0x62,0x6f,0x72,0x64,0x65,0x72,0x2d,0x63,0x6f,
+0x6c,0x6f,0x72,0x3a,0x20,0x67,0x72,0x61,0x79,0x3b,0x20,0x77,
+0x68,0x69,0x74,0x65,0x2d,0x73,0x70,0x61,0x63,0x65,0x3a,0x20,
+0x6e,0x6f,0x77,0x72,0x61,0x70,0x3b,0x7d,0x0a,0x09,0x74,0x61,
+0x62,0x6c,0x65,0x2e,0x74,0x62,0x6c,0x20,0x74,0x64,0x2e,0x61,
+0x6c,0x20,0x7b,0x20,0x74,0x65,0x78,0x74,0x2d,0x61,0x6c,0x69,
+0x67,0x6e,0x3a,0x20,0x6c,0x65,0x66,0x74,0x3b,0x7d,0x0a,0x09,
+0x74,0x61,0x62,0x6c,0x65,0x2e,0x74,0x62,0x6c,0x20,0x74,0x68,
+0x20,0x7b,0x20,0x62,0x6f,0x72,0x64,0x65,0x72,0x2d,0x77,0x69,
+0x64,0x74,0x68,0x3a,0x20,0x31,0x70,0x78,0x3b,0x20,0x62,0x6f,
+0x72,0x64,0x65,0x72,0x2d,0x73,0x74,0x79,0x6c,0x65,0x3a,0x20,
+0x73,0x6f,0x6c,0x69,0x64,0x20,0x73,0x6f,0x6c,0x69,0x64,0x20,
+0x73,0x6f,0x6c,0x69,0x64,0x20,0x73,0x6f,0x6c,0x69,0x64,0x3b,
+0x20,0x62,0x6f,0x72,0x64,0x65,0x72,0x2d,0x63,0x6f,0x6c,0x6f,
+0x72,0x3a,0x20,0x67,0x72,0x61,0x79,0x3b,0x7d,0x0a,0x2d,0x2d,
+0x3e,0x0a,0x3c,0x2f,0x73,0x74,0x79,0x6c,0x65,0x3e,0x0a,0x3c,
+0x2f,0x68,0x65,0x61,0x64,0x3e,0x0a,0x3c,0x62,0x6f,0x64,0x79,
+0x3e,0x0a,0x09,0x3c,0x68,0x31,0x3e,0x0a,0x09,0x09,0x3c,0x61,
+0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x68,0x74,0x74,0x70,0x73,
+0x3a,0x2f,0x2f,0x67,0x69,0x74,0x68,0x75,0x62,0x2e,0x63,0x6f,
+0x6d,0x2f,0x61,0x70,0x65,0x73,0x74,0x65,0x72,0x6e,0x69,0x6b,
+0x6f,0x76,0x2f,0x62,0x61,0x63,0x6b,0x70,0x6c,0x61,0x6e,0x65,
+0x22,0x20,0x73,0x74,0x79,0x6c,0x65,0x3d,0x22,0x74,0x65,0x78,
+0x74,0x2d,0x64,0x65,0x63,0x6f,0x72,0x61,0x74,0x69,0x6f,0x6e,
+0x3a,0x20,0x6e,0x6f,0x6e,0x65,0x3b,0x22,0x3e,0x42,0x61,0x63,
+0x6b,0x70,0x6c,0x61,0x6e,0x65,0x20,0x76,0x65,0x72,0x73,0x69,
+0x6f,0x6e,0x20,0x58,0x58,0x58,0x58,0x2c,0x20,0x72,0x65,0x6c,
+0x65,0x61,0x73,0x65,0x64,0x20,0x58,0x58,0x58,0x58,0x58,0x58,
+0x3c,0x2f,0x61,0x3e,0x0a,0x09,0x3c,0x2f,0x68,0x31,0x3e,0x0a,
+0x09,0x3c,0x68,0x32,0x3e,0x43,0x6c,0x75,0x73,0x74,0x65,0x72,
+0x20,0x52,0x65,0x70,0x6f,0x72,0x74,0x20,0x66,0x6f,0x72,0x20,
+0x62,0x61,0x63,0x6b,0x70,0x6c,0x61,0x6e,0x65,0x64,0x20,0x7b,
+0x7b,0x2e,0x4e,0x61,0x6d,0x65,0x7d,0x7d,0x20,0x6f,0x6e,0x20,
+0x7b,0x7b,0x2e,0x48,0x6f,0x73,0x74,0x6e,0x61,0x6d,0x65,0x7d,
+0x7d,0x3c,0x2f,0x68,0x32,0x3e,0x0a,0x09,0x3c,0x68,0x72,0x20,
+0x77,0x69,0x64,0x74,0x68,0x3d,0x22,0x31,0x30,0x30,0x25,0x22,
+0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x68,0x72,0x22,0x3e,
+0x0a,0x09,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x62,0x6f,0x72,
+0x64,0x65,0x72,0x3d,0x30,0x3e,0x0a,0x09,0x09,0x3c,0x74,0x72,
+0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,0x61,0x6c,0x69,
+0x67,0x6e,0x3d,0x22,0x6c,0x65,0x66,0x74,0x22,0x20,0x6e,0x6f,
+0x77,0x72,0x61,0x70,0x20,0x77,0x69,0x64,0x74,0x68,0x3d,0x22,
+0x31,0x25,0x22,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x62,0x72,
+0x3e,0x20,0x3c,0x62,0x3e,0x65,0x6e,0x63,0x72,0x79,0x70,0x74,
+0x65,0x64,0x20,0x3d,0x3c,0x2f,0x62,0x3e,0x20,0x7b,0x7b,0x20,
+0x69,0x66,0x20,0x2e,0x45,0x6e,0x63,0x72,0x79,0x70,0x74,0x65,
+0x64,0x20,0x7d,0x7d,0x79,0x65,0x73,0x7b,0x7b,0x20,0x65,0x6c,
+0x73,0x65,0x20,0x7d,0x7d,0x6e,0x6f,0x7b,0x7b,0x20,0x65,0x6e,
+0x64,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x3c,0x62,0x72,
+0x3e,0x20,0x3c,0x62,0x3e,0x61,0x75,0x74,0x68,0x20,0x66,0x61,
+0x69,0x6c,0x75,0x72,0x65,0x73,0x20,0x3d,0x3c,0x2f,0x62,0x3e,
+0x20,0x7b,0x7b,0x20,0x2e,0x41,0x75,0x74,0x68,0x46,0x61,0x69,
+0x6c,0x75,0x72,0x65,0x73,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,
+0x09,0x3c,0x62,0x72,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
+0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x3c,0x2f,0x74,0x61,
+0x62,0x6c,0x65,0x3e,0x0a,0x09,0x3c,0x68,0x33,0x3e,0x26,0x67,
+0x74,0x3b,0x20,0x4d,0x65,0x6d,0x62,0x65,0x72,0x73,0x20,0x28,
+0x3c,0x61,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x3f,0x66,0x6f,
+0x72,0x6d,0x61,0x74,0x3d,0x6a,0x73,0x6f,0x6e,0x22,0x3e,0x6a,
+0x73,0x6f,0x6e,0x3c,0x2f,0x61,0x3e,0x29,0x3c,0x2f,0x68,0x33,
+0x3e,0x0a,0x09,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x63,0x6c,
+0x61,0x73,0x73,0x3d,0x22,0x74,0x62,0x6c,0x22,0x20,0x77,0x69,
+0x64,0x74,0x68,0x3d,0x22,0x31,0x30,0x30,0x25,0x22,0x3e,0x0a,
+0x09,0x09,0x3c,0x74,0x72,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,
+0x22,0x74,0x69,0x74,0x72,0x65,0x22,0x3e,0x0a,0x09,0x09,0x09,
+0x3c,0x74,0x68,0x3e,0x4e,0x61,0x6d,0x65,0x3c,0x2f,0x74,0x68,
+0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x53,0x74,0x61,
+0x74,0x65,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,
+0x74,0x68,0x3e,0x49,0x6e,0x63,0x61,0x72,0x6e,0x61,0x74,0x69,
+0x6f,0x6e,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,
+0x74,0x68,0x3e,0x4c,0x61,0x73,0x74,0x20,0x63,0x68,0x61,0x6e,
+0x67,0x65,0x64,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,
+0x3c,0x74,0x68,0x3e,0x4d,0x65,0x74,0x61,0x64,0x61,0x74,0x61,
+0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x3c,0x2f,0x74,0x72,
+0x3e,0x0a,0x09,0x09,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,
+0x20,0x2e,0x4d,0x65,0x6d,0x62,0x65,0x72,0x73,0x20,0x7d,0x7d,
+0x0a,0x09,0x09,0x3c,0x74,0x72,0x20,0x63,0x6c,0x61,0x73,0x73,
+0x3d,0x22,0x7b,0x7b,0x20,0x69,0x66,0x20,0x65,0x71,0x20,0x2e,
+0x53,0x74,0x61,0x74,0x65,0x20,0x22,0x41,0x4c,0x49,0x56,0x45,
+0x22,0x20,0x7d,0x7d,0x61,0x63,0x74,0x69,0x76,0x65,0x34,0x7b,
+0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x69,0x66,0x20,0x65,0x71,
+0x20,0x2e,0x53,0x74,0x61,0x74,0x65,0x20,0x22,0x53,0x55,0x53,
+0x50,0x45,0x43,0x54,0x22,0x20,0x7d,0x7d,0x61,0x63,0x74,0x69,
+0x76,0x65,0x32,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,
+0x7d,0x61,0x63,0x74,0x69,0x76,0x65,0x30,0x7b,0x7b,0x20,0x65,
+0x6e,0x64,0x20,0x7d,0x7d,0x22,0x3e,0x0a,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x6c,0x3e,
+0x7b,0x7b,0x20,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7d,0x7d,0x7b,
+0x7b,0x20,0x69,0x66,0x20,0x65,0x71,0x20,0x2e,0x4e,0x61,0x6d,
+0x65,0x20,0x24,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7d,0x7d,0x20,
+0x5b,0x73,0x65,0x6c,0x66,0x5d,0x7b,0x7b,0x20,0x65,0x6e,0x64,
+0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
+0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x53,0x74,0x61,0x74,
+0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
+0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x49,0x6e,0x63,
+0x61,0x72,0x6e,0x61,0x74,0x69,0x6f,0x6e,0x20,0x7d,0x7d,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
+0x7b,0x7b,0x20,0x61,0x67,0x65,0x20,0x2e,0x4c,0x61,0x73,0x74,
+0x43,0x68,0x61,0x6e,0x67,0x65,0x64,0x20,0x7d,0x7d,0x20,0x61,
+0x67,0x6f,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x6c,0x3e,
+0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x24,0x6b,0x2c,
+0x20,0x24,0x76,0x20,0x3a,0x3d,0x20,0x2e,0x4d,0x65,0x74,0x61,
+0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x24,0x6b,0x20,0x7d,0x7d,0x3d,
+0x7b,0x7b,0x20,0x24,0x76,0x20,0x7d,0x7d,0x20,0x7b,0x7b,0x20,
+0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
+0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x7b,0x7b,
+0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x09,0x3c,0x2f,0x74,
+0x61,0x62,0x6c,0x65,0x3e,0x0a,0x3c,0x2f,0x62,0x6f,0x64,0x79,
+0x3e,0x0a,0x3c,0x2f,0x68,0x74,0x6d,0x6c,0x3e,0x0a,},
+	"cluster.html", 420, time.Unix(1792373212, 0),
+}
\ No newline at end of file
@@ -1,5 +1,5 @@
 package tpls
 import "github.com/apesternikov/bindata"
-var Files = []*bindata.Bindata{ Stats_html }
+var Files = []*bindata.Bindata{ Cluster_html, Stats_html }
 var Dirs = []*bindata.Dir{  }
 var Dir = &bindata.Dir{Pkg: "tpls", Files: Files, Dirs: Dirs, FullPkgName: "github.com/apesternikov/backplane/src/backplane/static/tpls"}
@@ -433,6 +433,8 @@ var Stats_html = &bindata.Bindata{ []byte{
 0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x53,0x65,
 0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,0x52,0x4c,0x20,0x44,0x72,
 0x6f,0x70,0x70,0x65,0x64,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,
+0x09,0x09,0x3c,0x74,0x68,0x3e,0x41,0x43,0x4c,0x20,0x44,0x72,
+0x6f,0x70,0x70,0x65,0x64,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,
 0x09,0x09,0x3c,0x74,0x68,0x3e,0x41,0x63,0x74,0x69,0x76,0x65,
 0x20,0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,0x3c,0x2f,0x74,
 0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x54,0x6f,
@@ -460,18 +462,20 @@ var Stats_html = &bindata.Bindata{ []byte{
 0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,
 0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4d,0x61,0x78,0x51,0x50,
 0x53,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
-0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x66,0x20,0x67,
-0x74,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,
-0x65,0x72,0x2e,0x54,0x61,0x72,0x67,0x65,0x74,0x51,0x50,0x53,
-0x20,0x39,0x39,0x39,0x39,0x39,0x39,0x20,0x7d,0x7d,0xe2,0x88,
-0x9e,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x7b,
-0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,
-0x65,0x72,0x2e,0x54,0x61,0x72,0x67,0x65,0x74,0x51,0x50,0x53,
-0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,
-0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,
-0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,
-0x69,0x74,0x65,0x72,0x2e,0x54,0x6f,0x74,0x61,0x6c,0x52,0x65,
-0x6a,0x65,0x63,0x74,0x65,0x64,0x43,0x6f,0x75,0x6e,0x74,0x20,
+0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x66,0x20,0x2e,
+0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,
+0x55,0x6e,0x6c,0x69,0x6d,0x69,0x74,0x65,0x64,0x20,0x7d,0x7d,
+0xe2,0x88,0x9e,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,
+0x7d,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,
+0x69,0x74,0x65,0x72,0x2e,0x54,0x61,0x72,0x67,0x65,0x74,0x51,
+0x50,0x53,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,
+0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,
+0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x54,0x6f,0x74,0x61,0x6c,
+0x52,0x65,0x6a,0x65,0x63,0x74,0x65,0x64,0x43,0x6f,0x75,0x6e,
+0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
+0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x41,0x63,0x6c,
+0x52,0x65,0x6a,0x65,0x63,0x74,0x65,0x64,0x43,0x6e,0x74,0x20,
 0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
 0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x41,0x63,0x74,0x69,0x76,
 0x65,0x43,0x6e,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,
@@ -504,18 +508,20 @@ var Stats_html = &bindata.Bindata{ []byte{
 0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,
 0x74,0x65,0x72,0x2e,0x4d,0x61,0x78,0x51,0x50,0x53,0x20,0x7d,
 0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,
-0x64,0x3e,0x7b,0x7b,0x20,0x69,0x66,0x20,0x67,0x74,0x20,0x2e,
-0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,
-0x54,0x61,0x72,0x67,0x65,0x74,0x51,0x50,0x53,0x20,0x39,0x39,
-0x39,0x39,0x39,0x39,0x20,0x7d,0x7d,0xe2,0x88,0x9e,0x7b,0x7b,
-0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x2e,
-0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,
-0x54,0x61,0x72,0x67,0x65,0x74,0x51,0x50,0x53,0x20,0x7d,0x7d,
-0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3c,0x2f,0x74,
-0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,
+0x64,0x3e,0x7b,0x7b,0x20,0x69,0x66,0x20,0x2e,0x52,0x61,0x74,
+0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x55,0x6e,0x6c,
+0x69,0x6d,0x69,0x74,0x65,0x64,0x20,0x7d,0x7d,0xe2,0x88,0x9e,
+0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x7b,0x7b,
 0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,
-0x72,0x2e,0x54,0x6f,0x74,0x61,0x6c,0x52,0x65,0x6a,0x65,0x63,
-0x74,0x65,0x64,0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,0x7d,0x3c,
+0x72,0x2e,0x54,0x61,0x72,0x67,0x65,0x74,0x51,0x50,0x53,0x20,
+0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
+0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,
+0x74,0x65,0x72,0x2e,0x54,0x6f,0x74,0x61,0x6c,0x52,0x65,0x6a,
+0x65,0x63,0x74,0x65,0x64,0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,
+0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,
+0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x41,0x63,0x6c,0x52,0x65,0x6a,
+0x65,0x63,0x74,0x65,0x64,0x43,0x6e,0x74,0x20,0x7d,0x7d,0x3c,
 0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
 0x7b,0x7b,0x20,0x2e,0x41,0x63,0x74,0x69,0x76,0x65,0x43,0x6e,
 0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
@@ -599,178 +605,103 @@ var Stats_html = &bindata.Bindata{ []byte{
 0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4d,0x61,0x78,
 0x51,0x50,0x53,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
 0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x66,
-0x20,0x67,0x74,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,
-0x69,0x74,0x65,0x72,0x2e,0x54,0x61,0x72,0x67,0x65,0x74,0x51,
-0x50,0x53,0x20,0x39,0x39,0x39,0x39,0x39,0x39,0x20,0x7d,0x7d,
-0xe2,0x88,0x9e,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,
-0x7d,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,
-0x69,0x74,0x65,0x72,0x2e,0x54,0x61,0x72,0x67,0x65,0x74,0x51,
-0x50,0x53,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,
-0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
-0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,
-0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x54,0x6f,0x74,0x61,0x6c,
-0x52,0x65,0x6a,0x65,0x63,0x74,0x65,0x64,0x43,0x6f,0x75,0x6e,
-0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
-0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,
-0x2e,0x43,0x75,0x72,0x41,0x63,0x74,0x69,0x76,0x65,0x53,0x65,
-0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,0x7d,0x7d,0x3c,0x2f,0x74,
-0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,
-0x20,0x24,0x63,0x6e,0x74,0x2e,0x4d,0x61,0x78,0x41,0x63,0x74,
-0x69,0x76,0x65,0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,
-0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
-0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x66,0x20,0x65,0x71,0x20,
-0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,0x69,0x6d,
-0x69,0x74,0x20,0x30,0x20,0x7d,0x7d,0xe2,0x88,0x9e,0x7b,0x7b,
-0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x2e,
-0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,0x69,0x6d,0x69,
-0x74,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,
-0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,
-0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,0x09,
-0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,
-0x54,0x6f,0x74,0x61,0x6c,0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,
-0x73,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x64,
-0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x74,0x69,0x70,
-0x73,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x61,
-0x62,0x6c,0x65,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x64,0x65,
-0x74,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
-0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
-0x74,0x68,0x3e,0x43,0x75,0x6d,0x2e,0x20,0x48,0x54,0x54,0x50,
-0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x73,0x3a,0x3c,0x2f,
-0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
-0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,
-0x54,0x6f,0x74,0x61,0x6c,0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,
+0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,
+0x72,0x2e,0x55,0x6e,0x6c,0x69,0x6d,0x69,0x74,0x65,0x64,0x20,
+0x7d,0x7d,0xe2,0x88,0x9e,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,
+0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,
+0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x54,0x61,0x72,0x67,0x65,
+0x74,0x51,0x50,0x53,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6e,
+0x64,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
+0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,
+0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x54,0x6f,0x74,
+0x61,0x6c,0x52,0x65,0x6a,0x65,0x63,0x74,0x65,0x64,0x43,0x6f,
+0x75,0x6e,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,
+0x6e,0x74,0x2e,0x43,0x75,0x72,0x41,0x63,0x74,0x69,0x76,0x65,
+0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,0x7d,0x7d,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
+0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,0x4d,0x61,0x78,0x41,
+0x63,0x74,0x69,0x76,0x65,0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,
 0x73,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
-0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,
-0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,
-0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,
-0x20,0x48,0x54,0x54,0x50,0x20,0x31,0x78,0x78,0x20,0x72,0x65,
-0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,
-0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
-0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,0x24,
-0x63,0x6e,0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,
-0x42,0x79,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,
-0x64,0x65,0x20,0x31,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,
-0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,
-0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,
-0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
-0x68,0x3e,0x2d,0x20,0x48,0x54,0x54,0x50,0x20,0x32,0x78,0x78,
-0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,
-0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
-0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,
-0x78,0x20,0x24,0x63,0x6e,0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,
-0x65,0x72,0x73,0x42,0x79,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,
-0x65,0x43,0x6f,0x64,0x65,0x20,0x32,0x20,0x7d,0x7d,0x3c,0x2f,
-0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
-0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
-0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
-0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,0x48,0x54,0x54,0x50,0x20,
-0x33,0x78,0x78,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,
-0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,
-0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,
-0x6e,0x64,0x65,0x78,0x20,0x24,0x63,0x6e,0x74,0x2e,0x43,0x6f,
-0x75,0x6e,0x74,0x65,0x72,0x73,0x42,0x79,0x52,0x65,0x73,0x70,
-0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x33,0x20,0x7d,
-0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
-0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x7b,0x7b,
+0x20,0x69,0x66,0x20,0x65,0x71,0x20,0x2e,0x4c,0x69,0x6d,0x69,
+0x74,0x65,0x72,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x20,0x30,0x20,
+0x7d,0x7d,0xe2,0x88,0x9e,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,
+0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x69,0x66,0x20,0x2e,
+0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x41,0x64,0x61,0x70,
+0x74,0x69,0x76,0x65,0x20,0x7d,0x7d,0x7e,0x7b,0x7b,0x20,0x65,
+0x6e,0x64,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x2e,0x4c,0x69,0x6d,
+0x69,0x74,0x65,0x72,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x20,0x7d,
+0x7d,0x7b,0x7b,0x20,0x77,0x69,0x74,0x68,0x20,0x2e,0x4c,0x69,
+0x6d,0x69,0x74,0x65,0x72,0x2e,0x51,0x75,0x65,0x75,0x65,0x64,
+0x20,0x7d,0x7d,0x20,0x2b,0x7b,0x7b,0x20,0x2e,0x20,0x7d,0x7d,
+0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,
+0x73,0x3d,0x74,0x69,0x70,0x73,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x63,0x6c,0x61,
+0x73,0x73,0x3d,0x64,0x65,0x74,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x7b,0x7b,0x20,0x77,0x69,0x74,0x68,0x20,0x2e,
+0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x41,0x64,0x61,0x70,
+0x74,0x69,0x76,0x65,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,
 0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,
-0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,0x48,0x54,
-0x54,0x50,0x20,0x34,0x78,0x78,0x20,0x72,0x65,0x73,0x70,0x6f,
-0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x41,0x64,0x61,0x70,
+0x74,0x69,0x76,0x65,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,
 0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,
-0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,0x24,0x63,0x6e,0x74,
-0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x42,0x79,0x52,
-0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,
-0x34,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
-0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,
-0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,
-0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,
-0x20,0x48,0x54,0x54,0x50,0x20,0x35,0x78,0x78,0x20,0x72,0x65,
-0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,
-0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
-0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,0x24,
-0x63,0x6e,0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,
-0x42,0x79,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,
-0x64,0x65,0x20,0x35,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,
+0x7b,0x20,0x2e,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x65,
+0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x68,0x3e,0x51,0x75,0x65,0x75,0x65,0x64,
+0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x4c,
+0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x51,0x75,0x65,0x75,0x65,
+0x64,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x77,0x69,0x74,0x68,0x20,
+0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x51,0x75,0x65,
+0x75,0x65,0x4c,0x69,0x6d,0x69,0x74,0x20,0x7d,0x7d,0x20,0x6f,
+0x66,0x20,0x7b,0x7b,0x20,0x2e,0x20,0x7d,0x7d,0x7b,0x7b,0x20,
+0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,
+0x3e,0x51,0x75,0x65,0x75,0x65,0x20,0x74,0x69,0x6d,0x65,0x6f,
+0x75,0x74,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,
+0x77,0x69,0x74,0x68,0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,
+0x72,0x2e,0x51,0x75,0x65,0x75,0x65,0x54,0x69,0x6d,0x65,0x6f,
+0x75,0x74,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x2e,0x20,0x7d,0x7d,
+0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x6e,0x6f,
+0x6e,0x65,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x68,0x3e,0x41,0x76,0x67,0x20,0x77,0x61,
+0x69,0x74,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,
+0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x41,0x76,0x67,
+0x57,0x61,0x69,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,
 0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,
 0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,
 0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
-0x68,0x3e,0x2d,0x20,0x6f,0x74,0x68,0x65,0x72,0x20,0x72,0x65,
-0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,
-0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
-0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,0x24,
-0x63,0x6e,0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,
-0x42,0x79,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,
-0x64,0x65,0x20,0x30,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,
-0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,
-0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x61,
-0x62,0x6c,0x65,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,
-0x64,0x69,0x76,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x2f,0x75,
-0x3e,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
-0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,
-0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,0x61,
-0x73,0x74,0x50,0x61,0x63,0x6b,0x65,0x74,0x20,0x7c,0x20,0x61,
-0x67,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
-0x09,0x09,0x3c,0x74,0x64,0x3e,0x58,0x58,0x3c,0x2f,0x74,0x64,
-0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x0a,0x09,0x09,
-0x09,0x09,0x58,0x58,0x58,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,
-0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,
-0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,
-0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
-0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x3c,0x2f,0x74,0x72,
-0x3e,0x0a,0x0a,0x09,0x09,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,
-0x65,0x20,0x2e,0x52,0x6f,0x75,0x74,0x65,0x73,0x7d,0x7d,0x0a,
-0x09,0x09,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x20,0x3a,0x3d,
-0x20,0x2e,0x47,0x65,0x74,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,
-0x73,0x20,0x7d,0x7d,0x0a,0x0a,0x09,0x09,0x3c,0x74,0x72,0x20,
-0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x61,0x63,0x74,0x69,0x76,
-0x65,0x34,0x22,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,
-0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x61,0x63,0x74,0x69,0x76,
-0x65,0x33,0x22,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
-0x09,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,
-0x6c,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x61,0x20,0x6e,0x61,
-0x6d,0x65,0x3d,0x22,0x68,0x74,0x74,0x70,0x2d,0x69,0x6e,0x2f,
-0x46,0x72,0x6f,0x6e,0x74,0x65,0x6e,0x64,0x22,0x3e,0x3c,0x2f,
-0x61,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x61,0x20,0x63,0x6c,
-0x61,0x73,0x73,0x3d,0x6c,0x66,0x73,0x62,0x20,0x68,0x72,0x65,
-0x66,0x3d,0x22,0x23,0x68,0x74,0x74,0x70,0x2d,0x69,0x6e,0x2f,
-0x46,0x72,0x6f,0x6e,0x74,0x65,0x6e,0x64,0x22,0x3e,0x7b,0x7b,
-0x2e,0x43,0x66,0x2e,0x50,0x61,0x74,0x68,0x7d,0x7d,0x20,0x2d,
-0x3e,0x20,0x7b,0x7b,0x20,0x2e,0x43,0x66,0x2e,0x42,0x61,0x63,
-0x6b,0x65,0x6e,0x64,0x4e,0x61,0x6d,0x65,0x20,0x7d,0x7d,0x3c,
-0x2f,0x61,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,
-0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,
-0x43,0x75,0x72,0x72,0x65,0x6e,0x74,0x51,0x50,0x53,0x20,0x7d,
-0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,
-0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,
-0x6d,0x69,0x74,0x65,0x72,0x2e,0x4d,0x61,0x78,0x51,0x50,0x53,
+0x68,0x3e,0x4d,0x61,0x78,0x20,0x77,0x61,0x69,0x74,0x3a,0x3c,
+0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x4c,0x69,0x6d,
+0x69,0x74,0x65,0x72,0x2e,0x4d,0x61,0x78,0x57,0x61,0x69,0x74,
 0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
-0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x66,0x20,0x67,0x74,
-0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,
-0x72,0x2e,0x54,0x61,0x72,0x67,0x65,0x74,0x51,0x50,0x53,0x20,
-0x39,0x39,0x39,0x39,0x39,0x39,0x20,0x7d,0x7d,0xe2,0x88,0x9e,
-0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x7b,0x7b,
-0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,
-0x72,0x2e,0x54,0x61,0x72,0x67,0x65,0x74,0x51,0x50,0x53,0x20,
-0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3c,
-0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
-0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,
-0x74,0x65,0x72,0x2e,0x54,0x6f,0x74,0x61,0x6c,0x52,0x65,0x6a,
-0x65,0x63,0x74,0x65,0x64,0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,
-0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,
-0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,0x43,0x75,
-0x72,0x41,0x63,0x74,0x69,0x76,0x65,0x53,0x65,0x73,0x73,0x69,
-0x6f,0x6e,0x73,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
-0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,
-0x6e,0x74,0x2e,0x4d,0x61,0x78,0x41,0x63,0x74,0x69,0x76,0x65,
-0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,0x7d,0x7d,0x3c,
-0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
-0x7b,0x7b,0x20,0x69,0x66,0x20,0x65,0x71,0x20,0x2e,0x4c,0x69,
-0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x20,
-0x30,0x20,0x7d,0x7d,0xe2,0x88,0x9e,0x7b,0x7b,0x20,0x65,0x6c,
-0x73,0x65,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x2e,0x4c,0x69,0x6d,
-0x69,0x74,0x65,0x72,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x20,0x7d,
-0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3c,0x2f,
+0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x44,0x72,
+0x6f,0x70,0x70,0x65,0x64,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
+0x7b,0x7b,0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,
+0x44,0x72,0x6f,0x70,0x70,0x65,0x64,0x20,0x7d,0x7d,0x3c,0x2f,
+0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x3c,0x2f,0x75,0x3e,0x0a,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,
+0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x3c,0x2f,
 0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x0a,
 0x09,0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,0x09,0x09,0x09,0x09,
 0x09,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,0x54,0x6f,0x74,
@@ -849,93 +780,742 @@ var Stats_html = &bindata.Bindata{ []byte{
 0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,
 0x30,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
 0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x77,0x69,0x74,
+0x68,0x20,0x24,0x63,0x6e,0x74,0x2e,0x47,0x72,0x70,0x63,0x45,
+0x72,0x72,0x6f,0x72,0x73,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,0x67,
+0x52,0x50,0x43,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x3a,0x3c,
+0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x20,0x7d,0x7d,
+0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x61,0x62,0x6c,
+0x65,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x64,0x69,
+0x76,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x2f,0x75,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
+0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,
+0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,0x61,0x73,0x74,
+0x50,0x61,0x63,0x6b,0x65,0x74,0x20,0x7c,0x20,0x61,0x67,0x65,
+0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
+0x3c,0x74,0x64,0x3e,0x58,0x58,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x7b,0x7b,0x20,0x77,0x69,0x74,0x68,0x20,0x2e,0x41,0x43,0x4c,
+0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x2e,0x52,0x65,0x6a,
+0x65,0x63,0x74,0x65,0x64,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,
+0x3d,0x74,0x69,0x70,0x73,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x63,0x6c,0x61,0x73,
+0x73,0x3d,0x64,0x65,0x74,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x4e,0x6f,0x74,0x20,0x61,
+0x6c,0x6c,0x6f,0x77,0x65,0x64,0x3a,0x3c,0x2f,0x74,0x68,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,
+0x3e,0x7b,0x7b,0x20,0x2e,0x44,0x65,0x66,0x61,0x75,0x6c,0x74,
+0x52,0x65,0x6a,0x65,0x63,0x74,0x65,0x64,0x20,0x7d,0x7d,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x2e,0x54,
+0x6f,0x70,0x52,0x65,0x6a,0x65,0x63,0x74,0x69,0x6e,0x67,0x20,
+0x31,0x30,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x68,0x3e,0x7b,0x7b,0x20,0x2e,0x20,0x7d,
+0x7d,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,
+0x52,0x65,0x6a,0x65,0x63,0x74,0x65,0x64,0x20,0x7d,0x7d,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x09,
 0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x61,0x62,0x6c,0x65,
 0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x64,0x69,0x76,
 0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x2f,0x75,0x3e,0x0a,0x09,
-0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
-0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,
-0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,0x61,0x73,0x74,0x50,
-0x61,0x63,0x6b,0x65,0x74,0x20,0x7c,0x20,0x61,0x67,0x65,0x20,
-0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
-0x74,0x64,0x3e,0x58,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
-0x09,0x3c,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x58,0x58,
-0x58,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,
+0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
+0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
 0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
 0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,0x74,0x64,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,0x74,0x64,
-0x3e,0x0a,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,
-0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x20,0x3c,0x21,
-0x2d,0x2d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x2e,0x52,0x6f,
-0x75,0x74,0x65,0x73,0x20,0x2d,0x2d,0x3e,0x0a,0x0a,0x09,0x09,
-0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x20,0x3c,0x21,
-0x2d,0x2d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x2e,0x56,0x68,
-0x6f,0x73,0x74,0x73,0x20,0x2d,0x2d,0x3e,0x0a,0x0a,0x09,0x09,
-0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x20,0x3a,0x3d,0x20,0x2e,
-0x47,0x65,0x74,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x20,
-0x7d,0x7d,0x20,0x3c,0x21,0x2d,0x2d,0x20,0x63,0x6f,0x75,0x6e,
-0x74,0x65,0x72,0x20,0x66,0x6f,0x72,0x20,0x74,0x68,0x65,0x20,
-0x66,0x72,0x6f,0x6e,0x74,0x65,0x6e,0x64,0x20,0x2d,0x2d,0x3e,
+0x0a,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x0a,0x09,0x09,
+0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x2e,0x52,0x6f,
+0x75,0x74,0x65,0x73,0x7d,0x7d,0x0a,0x09,0x09,0x7b,0x7b,0x20,
+0x24,0x63,0x6e,0x74,0x20,0x3a,0x3d,0x20,0x2e,0x47,0x65,0x74,
+0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x20,0x7d,0x7d,0x0a,
 0x0a,0x09,0x09,0x3c,0x74,0x72,0x20,0x63,0x6c,0x61,0x73,0x73,
-0x3d,0x22,0x66,0x72,0x6f,0x6e,0x74,0x65,0x6e,0x64,0x22,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,
-0x73,0x3d,0x61,0x63,0x20,0x63,0x6f,0x6c,0x73,0x70,0x61,0x6e,
-0x3d,0x22,0x32,0x22,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x61,
-0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x68,0x74,0x74,0x70,0x2d,
-0x69,0x6e,0x2f,0x46,0x72,0x6f,0x6e,0x74,0x65,0x6e,0x64,0x22,
-0x3e,0x3c,0x2f,0x61,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x61,
-0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x6c,0x66,0x73,0x62,0x20,
-0x68,0x72,0x65,0x66,0x3d,0x22,0x23,0x68,0x74,0x74,0x70,0x2d,
-0x69,0x6e,0x2f,0x46,0x72,0x6f,0x6e,0x74,0x65,0x6e,0x64,0x22,
-0x3e,0x46,0x72,0x6f,0x6e,0x74,0x65,0x6e,0x64,0x20,0x74,0x6f,
-0x74,0x61,0x6c,0x3c,0x2f,0x61,0x3e,0x0a,0x09,0x09,0x09,0x3c,
-0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
-0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,
-0x74,0x65,0x72,0x2e,0x43,0x75,0x72,0x72,0x65,0x6e,0x74,0x51,
-0x50,0x53,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
-0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,
-0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4d,0x61,
-0x78,0x51,0x50,0x53,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,
-0x66,0x20,0x67,0x74,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,
-0x6d,0x69,0x74,0x65,0x72,0x2e,0x54,0x61,0x72,0x67,0x65,0x74,
-0x51,0x50,0x53,0x20,0x39,0x39,0x39,0x39,0x39,0x39,0x20,0x7d,
-0x7d,0xe2,0x88,0x9e,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,
-0x7d,0x7d,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,
-0x6d,0x69,0x74,0x65,0x72,0x2e,0x54,0x61,0x72,0x67,0x65,0x74,
-0x51,0x50,0x53,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,
-0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
-0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,
-0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x54,0x6f,0x74,0x61,
-0x6c,0x52,0x65,0x6a,0x65,0x63,0x74,0x65,0x64,0x43,0x6f,0x75,
-0x6e,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
-0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,0x6e,
-0x74,0x2e,0x43,0x75,0x72,0x41,0x63,0x74,0x69,0x76,0x65,0x53,
-0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,0x7d,0x7d,0x3c,0x2f,
+0x3d,0x22,0x61,0x63,0x74,0x69,0x76,0x65,0x34,0x22,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,
+0x3d,0x22,0x61,0x63,0x74,0x69,0x76,0x65,0x33,0x22,0x3e,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,
+0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x6c,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x3c,0x61,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x68,
+0x74,0x74,0x70,0x2d,0x69,0x6e,0x2f,0x46,0x72,0x6f,0x6e,0x74,
+0x65,0x6e,0x64,0x22,0x3e,0x3c,0x2f,0x61,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x3c,0x61,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x6c,
+0x66,0x73,0x62,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x23,0x68,
+0x74,0x74,0x70,0x2d,0x69,0x6e,0x2f,0x46,0x72,0x6f,0x6e,0x74,
+0x65,0x6e,0x64,0x22,0x3e,0x7b,0x7b,0x2e,0x43,0x66,0x2e,0x50,
+0x61,0x74,0x68,0x7d,0x7d,0x20,0x2d,0x3e,0x20,0x7b,0x7b,0x20,
+0x2e,0x43,0x66,0x2e,0x42,0x61,0x63,0x6b,0x65,0x6e,0x64,0x4e,
+0x61,0x6d,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x61,0x3e,0x0a,0x09,
+0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,
+0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x43,0x75,0x72,0x72,0x65,
+0x6e,0x74,0x51,0x50,0x53,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,
+0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,
+0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,
+0x2e,0x4d,0x61,0x78,0x51,0x50,0x53,0x20,0x7d,0x7d,0x3c,0x2f,
+0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,
+0x7b,0x20,0x69,0x66,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,
+0x6d,0x69,0x74,0x65,0x72,0x2e,0x55,0x6e,0x6c,0x69,0x6d,0x69,
+0x74,0x65,0x64,0x20,0x7d,0x7d,0xe2,0x88,0x9e,0x7b,0x7b,0x20,
+0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x2e,0x52,
+0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x54,
+0x61,0x72,0x67,0x65,0x74,0x51,0x50,0x53,0x20,0x7d,0x7d,0x7b,
+0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,
+0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,
+0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,
+0x2e,0x54,0x6f,0x74,0x61,0x6c,0x52,0x65,0x6a,0x65,0x63,0x74,
+0x65,0x64,0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,0x7d,0x3c,0x2f,
 0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,
-0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,0x4d,0x61,0x78,0x41,0x63,
+0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,0x43,0x75,0x72,0x41,0x63,
 0x74,0x69,0x76,0x65,0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,
 0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
-0x3c,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x58,0x58,0x58,
-0x58,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
-0x09,0x09,0x3c,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,
-0x75,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x24,
-0x63,0x6e,0x74,0x2e,0x54,0x6f,0x74,0x61,0x6c,0x53,0x65,0x73,
-0x73,0x69,0x6f,0x6e,0x73,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,
-0x09,0x09,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,
-0x3d,0x74,0x69,0x70,0x73,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
-0x09,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x63,0x6c,0x61,0x73,
-0x73,0x3d,0x64,0x65,0x74,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
-0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
-0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x43,0x75,0x6d,0x2e,0x20,
-0x48,0x54,0x54,0x50,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,
-0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,
-0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,
-0x63,0x6e,0x74,0x2e,0x54,0x6f,0x74,0x61,0x6c,0x53,0x65,0x73,
+0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,
+0x4d,0x61,0x78,0x41,0x63,0x74,0x69,0x76,0x65,0x53,0x65,0x73,
 0x73,0x69,0x6f,0x6e,0x73,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,
+0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x7b,0x7b,0x20,0x69,0x66,0x20,0x65,0x71,0x20,0x2e,
+0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,0x69,0x6d,0x69,
+0x74,0x20,0x30,0x20,0x7d,0x7d,0xe2,0x88,0x9e,0x7b,0x7b,0x20,
+0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,
+0x3c,0x75,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,
+0x69,0x66,0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,
+0x41,0x64,0x61,0x70,0x74,0x69,0x76,0x65,0x20,0x7d,0x7d,0x7e,
+0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x7b,0x7b,0x20,
+0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,0x69,0x6d,
+0x69,0x74,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x77,0x69,0x74,0x68,
+0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x51,0x75,
+0x65,0x75,0x65,0x64,0x20,0x7d,0x7d,0x20,0x2b,0x7b,0x7b,0x20,
+0x2e,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,
+0x7d,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x64,0x69,0x76,0x20,
+0x63,0x6c,0x61,0x73,0x73,0x3d,0x74,0x69,0x70,0x73,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x61,0x62,0x6c,0x65,
+0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x64,0x65,0x74,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x77,0x69,
+0x74,0x68,0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,
+0x41,0x64,0x61,0x70,0x74,0x69,0x76,0x65,0x20,0x7d,0x7d,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,
+0x41,0x64,0x61,0x70,0x74,0x69,0x76,0x65,0x3a,0x3c,0x2f,0x74,
+0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x20,0x7d,0x7d,0x3c,0x2f,
+0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x51,0x75,
+0x65,0x75,0x65,0x64,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,
+0x7b,0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x51,
+0x75,0x65,0x75,0x65,0x64,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x77,
+0x69,0x74,0x68,0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,
+0x2e,0x51,0x75,0x65,0x75,0x65,0x4c,0x69,0x6d,0x69,0x74,0x20,
+0x7d,0x7d,0x20,0x6f,0x66,0x20,0x7b,0x7b,0x20,0x2e,0x20,0x7d,
+0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3c,0x2f,
+0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x74,0x68,0x3e,0x51,0x75,0x65,0x75,0x65,0x20,0x74,
+0x69,0x6d,0x65,0x6f,0x75,0x74,0x3a,0x3c,0x2f,0x74,0x68,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,
+0x3e,0x7b,0x7b,0x20,0x77,0x69,0x74,0x68,0x20,0x2e,0x4c,0x69,
+0x6d,0x69,0x74,0x65,0x72,0x2e,0x51,0x75,0x65,0x75,0x65,0x54,
+0x69,0x6d,0x65,0x6f,0x75,0x74,0x20,0x7d,0x7d,0x7b,0x7b,0x20,
+0x2e,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,
+0x7d,0x7d,0x6e,0x6f,0x6e,0x65,0x7b,0x7b,0x20,0x65,0x6e,0x64,
+0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x41,0x76,
+0x67,0x20,0x77,0x61,0x69,0x74,0x3a,0x3c,0x2f,0x74,0x68,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,
+0x3e,0x7b,0x7b,0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,
+0x2e,0x41,0x76,0x67,0x57,0x61,0x69,0x74,0x20,0x7d,0x7d,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x68,0x3e,0x4d,0x61,0x78,0x20,0x77,0x61,
+0x69,0x74,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,
+0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4d,0x61,0x78,
+0x57,0x61,0x69,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
+0x68,0x3e,0x44,0x72,0x6f,0x70,0x70,0x65,0x64,0x3a,0x3c,0x2f,
+0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x4c,0x69,0x6d,0x69,
+0x74,0x65,0x72,0x2e,0x44,0x72,0x6f,0x70,0x70,0x65,0x64,0x20,
+0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x3c,0x2f,0x75,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x09,
+0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,
+0x2e,0x54,0x6f,0x74,0x61,0x6c,0x53,0x65,0x73,0x73,0x69,0x6f,
+0x6e,0x73,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x74,0x69,
+0x70,0x73,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
+0x61,0x62,0x6c,0x65,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x64,
+0x65,0x74,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x74,0x68,0x3e,0x43,0x75,0x6d,0x2e,0x20,0x48,0x54,0x54,
+0x50,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x73,0x3a,0x3c,
+0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,
+0x2e,0x54,0x6f,0x74,0x61,0x6c,0x53,0x65,0x73,0x73,0x69,0x6f,
+0x6e,0x73,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,
+0x2d,0x20,0x48,0x54,0x54,0x50,0x20,0x31,0x78,0x78,0x20,0x72,
+0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,
+0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,
+0x24,0x63,0x6e,0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,
+0x73,0x42,0x79,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,
+0x6f,0x64,0x65,0x20,0x31,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,
 0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,
 0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
 0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
-0x74,0x68,0x3e,0x2d,0x20,0x48,0x54,0x54,0x50,0x20,0x31,0x78,
+0x74,0x68,0x3e,0x2d,0x20,0x48,0x54,0x54,0x50,0x20,0x32,0x78,
+0x78,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,
+0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,
+0x65,0x78,0x20,0x24,0x63,0x6e,0x74,0x2e,0x43,0x6f,0x75,0x6e,
+0x74,0x65,0x72,0x73,0x42,0x79,0x52,0x65,0x73,0x70,0x6f,0x6e,
+0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x32,0x20,0x7d,0x7d,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,0x48,0x54,0x54,0x50,
+0x20,0x33,0x78,0x78,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,
+0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,
+0x69,0x6e,0x64,0x65,0x78,0x20,0x24,0x63,0x6e,0x74,0x2e,0x43,
+0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x42,0x79,0x52,0x65,0x73,
+0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x33,0x20,
+0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,0x48,
+0x54,0x54,0x50,0x20,0x34,0x78,0x78,0x20,0x72,0x65,0x73,0x70,
+0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
+0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,0x24,0x63,0x6e,
+0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x42,0x79,
+0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,
+0x20,0x34,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,
+0x2d,0x20,0x48,0x54,0x54,0x50,0x20,0x35,0x78,0x78,0x20,0x72,
+0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,
+0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,
+0x24,0x63,0x6e,0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,
+0x73,0x42,0x79,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,
+0x6f,0x64,0x65,0x20,0x35,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,
+0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
+0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x74,0x68,0x3e,0x2d,0x20,0x6f,0x74,0x68,0x65,0x72,0x20,0x72,
+0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,
+0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,
+0x24,0x63,0x6e,0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,
+0x73,0x42,0x79,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,
+0x6f,0x64,0x65,0x20,0x30,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,
+0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,
+0x20,0x77,0x69,0x74,0x68,0x20,0x24,0x63,0x6e,0x74,0x2e,0x47,
+0x72,0x70,0x63,0x45,0x72,0x72,0x6f,0x72,0x73,0x20,0x7d,0x7d,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,
+0x3e,0x2d,0x20,0x67,0x52,0x50,0x43,0x20,0x65,0x72,0x72,0x6f,
+0x72,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,
+0x2e,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,
+0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,
+0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,
+0x2f,0x75,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,
+0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,
+0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,
+0x4c,0x61,0x73,0x74,0x50,0x61,0x63,0x6b,0x65,0x74,0x20,0x7c,
+0x20,0x61,0x67,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,
+0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x58,0x3c,0x2f,0x74,
+0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x7b,0x7b,0x20,0x77,0x69,0x74,0x68,0x20,0x2e,
+0x41,0x43,0x4c,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x3c,
+0x75,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x2e,
+0x52,0x65,0x6a,0x65,0x63,0x74,0x65,0x64,0x20,0x7d,0x7d,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,
+0x61,0x73,0x73,0x3d,0x74,0x69,0x70,0x73,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x63,
+0x6c,0x61,0x73,0x73,0x3d,0x64,0x65,0x74,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x4e,0x6f,
+0x74,0x20,0x61,0x6c,0x6c,0x6f,0x77,0x65,0x64,0x3a,0x3c,0x2f,
+0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x44,0x65,0x66,0x61,
+0x75,0x6c,0x74,0x52,0x65,0x6a,0x65,0x63,0x74,0x65,0x64,0x20,
+0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,
+0x20,0x2e,0x54,0x6f,0x70,0x52,0x65,0x6a,0x65,0x63,0x74,0x69,
+0x6e,0x67,0x20,0x31,0x30,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x7b,0x7b,0x20,
+0x2e,0x20,0x7d,0x7d,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,
+0x7b,0x20,0x2e,0x52,0x65,0x6a,0x65,0x63,0x74,0x65,0x64,0x20,
+0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,
+0x7d,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x61,
+0x62,0x6c,0x65,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,
+0x64,0x69,0x76,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x2f,0x75,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,
+0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,
+0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,0x74,0x64,
+0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,0x74,
+0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,
+0x74,0x64,0x3e,0x0a,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,
+0x09,0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x20,
+0x3c,0x21,0x2d,0x2d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x2e,
+0x52,0x6f,0x75,0x74,0x65,0x73,0x20,0x2d,0x2d,0x3e,0x0a,0x0a,
+0x09,0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x20,
+0x3c,0x21,0x2d,0x2d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x2e,
+0x56,0x68,0x6f,0x73,0x74,0x73,0x20,0x2d,0x2d,0x3e,0x0a,0x0a,
+0x09,0x09,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x20,0x3a,0x3d,
+0x20,0x2e,0x47,0x65,0x74,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,
+0x73,0x20,0x7d,0x7d,0x20,0x3c,0x21,0x2d,0x2d,0x20,0x63,0x6f,
+0x75,0x6e,0x74,0x65,0x72,0x20,0x66,0x6f,0x72,0x20,0x74,0x68,
+0x65,0x20,0x66,0x72,0x6f,0x6e,0x74,0x65,0x6e,0x64,0x20,0x2d,
+0x2d,0x3e,0x0a,0x09,0x09,0x3c,0x74,0x72,0x20,0x63,0x6c,0x61,
+0x73,0x73,0x3d,0x22,0x66,0x72,0x6f,0x6e,0x74,0x65,0x6e,0x64,
+0x22,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,0x63,0x6c,
+0x61,0x73,0x73,0x3d,0x61,0x63,0x20,0x63,0x6f,0x6c,0x73,0x70,
+0x61,0x6e,0x3d,0x22,0x32,0x22,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x3c,0x61,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x68,0x74,0x74,
+0x70,0x2d,0x69,0x6e,0x2f,0x46,0x72,0x6f,0x6e,0x74,0x65,0x6e,
+0x64,0x22,0x3e,0x3c,0x2f,0x61,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x3c,0x61,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x6c,0x66,0x73,
+0x62,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x23,0x68,0x74,0x74,
+0x70,0x2d,0x69,0x6e,0x2f,0x46,0x72,0x6f,0x6e,0x74,0x65,0x6e,
+0x64,0x22,0x3e,0x46,0x72,0x6f,0x6e,0x74,0x65,0x6e,0x64,0x20,
+0x74,0x6f,0x74,0x61,0x6c,0x3c,0x2f,0x61,0x3e,0x0a,0x09,0x09,
+0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,
+0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,
+0x6d,0x69,0x74,0x65,0x72,0x2e,0x43,0x75,0x72,0x72,0x65,0x6e,
+0x74,0x51,0x50,0x53,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,
+0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,
+0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,
+0x4d,0x61,0x78,0x51,0x50,0x53,0x20,0x7d,0x7d,0x3c,0x2f,0x74,
+0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,
+0x20,0x69,0x66,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,
+0x69,0x74,0x65,0x72,0x2e,0x55,0x6e,0x6c,0x69,0x6d,0x69,0x74,
+0x65,0x64,0x20,0x7d,0x7d,0xe2,0x88,0x9e,0x7b,0x7b,0x20,0x65,
+0x6c,0x73,0x65,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x2e,0x52,0x61,
+0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x54,0x61,
+0x72,0x67,0x65,0x74,0x51,0x50,0x53,0x20,0x7d,0x7d,0x7b,0x7b,
+0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,
+0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,
+0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,
+0x54,0x6f,0x74,0x61,0x6c,0x52,0x65,0x6a,0x65,0x63,0x74,0x65,
+0x64,0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,
+0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,
+0x20,0x24,0x63,0x6e,0x74,0x2e,0x43,0x75,0x72,0x41,0x63,0x74,
+0x69,0x76,0x65,0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,
+0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,0x4d,
+0x61,0x78,0x41,0x63,0x74,0x69,0x76,0x65,0x53,0x65,0x73,0x73,
+0x69,0x6f,0x6e,0x73,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,
+0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x58,0x58,0x58,0x58,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,
+0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,0x54,0x6f,0x74,0x61,
+0x6c,0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,0x7d,0x7d,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x64,0x69,0x76,0x20,0x63,
+0x6c,0x61,0x73,0x73,0x3d,0x74,0x69,0x70,0x73,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,
+0x63,0x6c,0x61,0x73,0x73,0x3d,0x64,0x65,0x74,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x43,
+0x75,0x6d,0x2e,0x20,0x48,0x54,0x54,0x50,0x20,0x72,0x65,0x71,
+0x75,0x65,0x73,0x74,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
+0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,0x54,0x6f,0x74,0x61,
+0x6c,0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,0x7d,0x7d,
+0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,0x48,0x54,0x54,
+0x50,0x20,0x31,0x78,0x78,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,
+0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,
+0x20,0x69,0x6e,0x64,0x65,0x78,0x20,0x24,0x63,0x6e,0x74,0x2e,
+0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x42,0x79,0x52,0x65,
+0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x31,
+0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,
+0x48,0x54,0x54,0x50,0x20,0x32,0x78,0x78,0x20,0x72,0x65,0x73,
+0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,
+0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,0x24,0x63,
+0x6e,0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x42,
+0x79,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,
+0x65,0x20,0x32,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,
+0x3e,0x2d,0x20,0x48,0x54,0x54,0x50,0x20,0x33,0x78,0x78,0x20,
+0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,
+0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,
+0x20,0x24,0x63,0x6e,0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,
+0x72,0x73,0x42,0x79,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,
+0x43,0x6f,0x64,0x65,0x20,0x33,0x20,0x7d,0x7d,0x3c,0x2f,0x74,
+0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,
+0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x74,0x68,0x3e,0x2d,0x20,0x48,0x54,0x54,0x50,0x20,0x34,
+0x78,0x78,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,
+0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,
+0x64,0x65,0x78,0x20,0x24,0x63,0x6e,0x74,0x2e,0x43,0x6f,0x75,
+0x6e,0x74,0x65,0x72,0x73,0x42,0x79,0x52,0x65,0x73,0x70,0x6f,
+0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x34,0x20,0x7d,0x7d,
+0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,0x48,0x54,0x54,
+0x50,0x20,0x35,0x78,0x78,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,
+0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,
+0x20,0x69,0x6e,0x64,0x65,0x78,0x20,0x24,0x63,0x6e,0x74,0x2e,
+0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x42,0x79,0x52,0x65,
+0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x35,
+0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,
+0x6f,0x74,0x68,0x65,0x72,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,
+0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,
+0x20,0x69,0x6e,0x64,0x65,0x78,0x20,0x24,0x63,0x6e,0x74,0x2e,
+0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x42,0x79,0x52,0x65,
+0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x30,
+0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x77,0x69,0x74,0x68,
+0x20,0x24,0x63,0x6e,0x74,0x2e,0x47,0x72,0x70,0x63,0x45,0x72,
+0x72,0x6f,0x72,0x73,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,0x67,0x52,
+0x50,0x43,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x3a,0x3c,0x2f,
+0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x20,0x7d,0x7d,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x61,0x62,0x6c,0x65,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x64,0x69,0x76,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x2f,0x75,0x3e,0x0a,0x09,
+0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,
+0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,0x61,0x73,0x74,0x50,
+0x61,0x63,0x6b,0x65,0x74,0x20,0x7c,0x20,0x61,0x67,0x65,0x20,
+0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x3e,0x58,0x58,0x58,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x7b,0x7b,0x20,0x77,0x69,0x74,0x68,0x20,0x2e,0x41,0x43,0x4c,
+0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x2e,0x52,0x65,0x6a,
+0x65,0x63,0x74,0x65,0x64,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,
+0x3d,0x74,0x69,0x70,0x73,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x63,0x6c,0x61,0x73,
+0x73,0x3d,0x64,0x65,0x74,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x4e,0x6f,0x74,0x20,0x61,
+0x6c,0x6c,0x6f,0x77,0x65,0x64,0x3a,0x3c,0x2f,0x74,0x68,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,
+0x3e,0x7b,0x7b,0x20,0x2e,0x44,0x65,0x66,0x61,0x75,0x6c,0x74,
+0x52,0x65,0x6a,0x65,0x63,0x74,0x65,0x64,0x20,0x7d,0x7d,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x7b,0x7b,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x2e,0x54,
+0x6f,0x70,0x52,0x65,0x6a,0x65,0x63,0x74,0x69,0x6e,0x67,0x20,
+0x31,0x30,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x68,0x3e,0x7b,0x7b,0x20,0x2e,0x20,0x7d,
+0x7d,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,
+0x52,0x65,0x6a,0x65,0x63,0x74,0x65,0x64,0x20,0x7d,0x7d,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x61,0x62,0x6c,0x65,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x64,0x69,0x76,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x2f,0x75,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,
+0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
+0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
+0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,0x74,0x64,0x3e,
+0x0a,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x3c,0x2f,
+0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x09,0x3c,0x62,0x72,0x3e,
+0x0a,0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x20,
+0x3c,0x21,0x2d,0x2d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x2e,
+0x46,0x72,0x6f,0x6e,0x74,0x65,0x6e,0x64,0x20,0x2d,0x2d,0x3e,
+0x0a,0x09,0x7b,0x7b,0x72,0x61,0x6e,0x67,0x65,0x20,0x2e,0x42,
+0x61,0x63,0x6b,0x65,0x6e,0x64,0x73,0x7d,0x7d,0x0a,0x09,0x3c,
+0x74,0x61,0x62,0x6c,0x65,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,
+0x22,0x74,0x62,0x6c,0x22,0x20,0x77,0x69,0x64,0x74,0x68,0x3d,
+0x22,0x31,0x30,0x30,0x25,0x22,0x3e,0x0a,0x09,0x09,0x3c,0x74,
+0x72,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x69,0x74,
+0x72,0x65,0x22,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x20,
+0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x70,0x78,0x6e,0x61,0x6d,
+0x65,0x22,0x20,0x77,0x69,0x64,0x74,0x68,0x3d,0x22,0x31,0x30,
+0x25,0x22,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x61,0x20,0x6e,
+0x61,0x6d,0x65,0x3d,0x22,0x73,0x74,0x61,0x74,0x73,0x22,0x3e,
+0x3c,0x2f,0x61,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x61,0x20,
+0x63,0x6c,0x61,0x73,0x73,0x3d,0x70,0x78,0x20,0x68,0x72,0x65,
+0x66,0x3d,0x22,0x23,0x73,0x74,0x61,0x74,0x73,0x22,0x3e,0x42,
+0x61,0x63,0x6b,0x65,0x6e,0x64,0x20,0x7b,0x7b,0x20,0x2e,0x43,
+0x66,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x61,
+0x3e,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,
+0x09,0x09,0x3c,0x74,0x68,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,
+0x22,0x65,0x6d,0x70,0x74,0x79,0x22,0x20,0x77,0x69,0x64,0x74,
+0x68,0x3d,0x22,0x39,0x30,0x25,0x22,0x3e,0x3c,0x2f,0x74,0x68,
+0x3e,0x0a,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x3c,
+0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x0a,0x09,0x3c,0x74,
+0x61,0x62,0x6c,0x65,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,
+0x74,0x62,0x6c,0x22,0x20,0x77,0x69,0x64,0x74,0x68,0x3d,0x22,
+0x31,0x30,0x30,0x25,0x22,0x3e,0x0a,0x09,0x09,0x3c,0x74,0x72,
+0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x69,0x74,0x72,
+0x65,0x22,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x20,0x72,
+0x6f,0x77,0x73,0x70,0x61,0x6e,0x3d,0x32,0x3e,0x3c,0x2f,0x74,
+0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x20,0x63,0x6f,
+0x6c,0x73,0x70,0x61,0x6e,0x3d,0x34,0x3e,0x52,0x65,0x71,0x75,
+0x65,0x73,0x74,0x73,0x20,0x72,0x61,0x74,0x65,0x3c,0x2f,0x74,
+0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x20,0x63,0x6f,
+0x6c,0x73,0x70,0x61,0x6e,0x3d,0x35,0x3e,0x52,0x65,0x71,0x75,
+0x65,0x73,0x74,0x73,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,
+0x09,0x3c,0x74,0x68,0x20,0x63,0x6f,0x6c,0x73,0x70,0x61,0x6e,
+0x3d,0x32,0x3e,0x44,0x65,0x6e,0x69,0x65,0x64,0x3c,0x2f,0x74,
+0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x20,0x63,0x6f,
+0x6c,0x73,0x70,0x61,0x6e,0x3d,0x33,0x3e,0x45,0x72,0x72,0x6f,
+0x72,0x73,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,
+0x74,0x68,0x20,0x63,0x6f,0x6c,0x73,0x70,0x61,0x6e,0x3d,0x32,
+0x3e,0x57,0x61,0x72,0x6e,0x69,0x6e,0x67,0x73,0x3c,0x2f,0x74,
+0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x20,0x63,0x6f,
+0x6c,0x73,0x70,0x61,0x6e,0x3d,0x39,0x3e,0x53,0x65,0x72,0x76,
+0x65,0x72,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x3c,0x2f,
+0x74,0x72,0x3e,0x0a,0x09,0x09,0x3c,0x74,0x72,0x20,0x63,0x6c,
+0x61,0x73,0x73,0x3d,0x22,0x74,0x69,0x74,0x72,0x65,0x22,0x3e,
+0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x43,0x75,0x72,0x3c,
+0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,
+0x4d,0x61,0x78,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,
+0x3c,0x74,0x68,0x3e,0x4c,0x69,0x6d,0x69,0x74,0x3c,0x2f,0x74,
+0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x44,0x65,
+0x6e,0x69,0x65,0x64,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,
+0x09,0x3c,0x74,0x68,0x3e,0x43,0x75,0x72,0x3c,0x2f,0x74,0x68,
+0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x4d,0x61,0x78,
+0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,
+0x3e,0x4c,0x69,0x6d,0x69,0x74,0x3c,0x2f,0x74,0x68,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x54,0x6f,0x74,0x61,0x6c,
+0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,
+0x3e,0x4c,0x61,0x73,0x74,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,
+0x09,0x09,0x3c,0x74,0x68,0x3e,0x52,0x65,0x71,0x3c,0x2f,0x74,
+0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x52,0x65,
+0x73,0x70,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,
+0x74,0x68,0x3e,0x52,0x65,0x71,0x3c,0x2f,0x74,0x68,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x43,0x6f,0x6e,0x6e,0x3c,
+0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,
+0x52,0x65,0x73,0x70,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,
+0x09,0x3c,0x74,0x68,0x3e,0x52,0x65,0x74,0x72,0x3c,0x2f,0x74,
+0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x52,0x65,
+0x64,0x69,0x73,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,
+0x3c,0x74,0x68,0x3e,0x53,0x74,0x61,0x74,0x75,0x73,0x3c,0x2f,
+0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x4c,
+0x61,0x73,0x74,0x43,0x68,0x6b,0x3c,0x2f,0x74,0x68,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x57,0x67,0x68,0x74,0x3c,
+0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,
+0x41,0x63,0x74,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,
+0x3c,0x74,0x68,0x3e,0x42,0x63,0x6b,0x3c,0x2f,0x74,0x68,0x3e,
+0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x43,0x68,0x6b,0x3c,
+0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,
+0x44,0x77,0x6e,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,
+0x3c,0x74,0x68,0x3e,0x44,0x77,0x6e,0x74,0x6d,0x65,0x3c,0x2f,
+0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x54,
+0x68,0x72,0x74,0x6c,0x65,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,
+0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x7b,0x7b,0x20,
+0x72,0x61,0x6e,0x67,0x65,0x20,0x2e,0x47,0x65,0x74,0x53,0x65,
+0x72,0x76,0x65,0x72,0x73,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x7b,
+0x7b,0x20,0x24,0x63,0x6e,0x74,0x20,0x3a,0x3d,0x20,0x2e,0x47,
+0x65,0x74,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x20,0x7d,
+0x7d,0x0a,0x09,0x09,0x3c,0x74,0x72,0x20,0x63,0x6c,0x61,0x73,
+0x73,0x3d,0x22,0x7b,0x7b,0x20,0x69,0x66,0x20,0x2e,0x48,0x65,
+0x61,0x6c,0x74,0x68,0x43,0x68,0x65,0x63,0x6b,0x65,0x72,0x2e,
+0x49,0x73,0x48,0x65,0x61,0x6c,0x74,0x68,0x79,0x20,0x7d,0x7d,
+0x61,0x63,0x74,0x69,0x76,0x65,0x34,0x7b,0x7b,0x20,0x65,0x6c,
+0x73,0x65,0x20,0x7d,0x7d,0x61,0x63,0x74,0x69,0x76,0x65,0x30,
+0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x22,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,
+0x3d,0x61,0x63,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x61,0x20,
+0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,0x61,0x67,0x65,0x32,0x72,
+0x73,0x73,0x2f,0x68,0x32,0x22,0x3e,0x3c,0x2f,0x61,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x3c,0x61,0x20,0x63,0x6c,0x61,0x73,0x73,
+0x3d,0x6c,0x66,0x73,0x62,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,
+0x23,0x70,0x61,0x67,0x65,0x32,0x72,0x73,0x73,0x2f,0x68,0x32,
+0x22,0x3e,0x7b,0x7b,0x20,0x2e,0x43,0x66,0x2e,0x41,0x64,0x64,
+0x72,0x65,0x73,0x73,0x20,0x7d,0x7d,0x3c,0x2f,0x61,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x0a,0x09,0x09,
+0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,
+0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x43,0x75,0x72,
+0x72,0x65,0x6e,0x74,0x51,0x50,0x53,0x20,0x7d,0x7d,0x3c,0x2f,
+0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,
+0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,
+0x65,0x72,0x2e,0x4d,0x61,0x78,0x51,0x50,0x53,0x20,0x7d,0x7d,
+0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,
+0x3e,0x7b,0x7b,0x20,0x69,0x66,0x20,0x2e,0x52,0x61,0x74,0x65,
+0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x55,0x6e,0x6c,0x69,
+0x6d,0x69,0x74,0x65,0x64,0x20,0x7d,0x7d,0xe2,0x88,0x9e,0x7b,
+0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x7b,0x7b,0x20,
+0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,
+0x2e,0x54,0x61,0x72,0x67,0x65,0x74,0x51,0x50,0x53,0x20,0x7d,
+0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3c,0x2f,
+0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,
+0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,
+0x65,0x72,0x2e,0x54,0x6f,0x74,0x61,0x6c,0x52,0x65,0x6a,0x65,
+0x63,0x74,0x65,0x64,0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,0x7d,
+0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x0a,0x09,0x09,0x09,0x3c,0x74,
+0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,0x43,0x75,
+0x72,0x41,0x63,0x74,0x69,0x76,0x65,0x53,0x65,0x73,0x73,0x69,
+0x6f,0x6e,0x73,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,
+0x6e,0x74,0x2e,0x4d,0x61,0x78,0x41,0x63,0x74,0x69,0x76,0x65,
+0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,0x7d,0x7d,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x69,0x66,0x20,0x65,
+0x71,0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,
+0x69,0x6d,0x69,0x74,0x20,0x30,0x20,0x7d,0x7d,0xe2,0x88,0x9e,
+0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x0a,0x09,
+0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x7b,0x7b,0x20,0x69,0x66,0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,
+0x65,0x72,0x2e,0x41,0x64,0x61,0x70,0x74,0x69,0x76,0x65,0x20,
+0x7d,0x7d,0x7e,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,
+0x7b,0x7b,0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,
+0x4c,0x69,0x6d,0x69,0x74,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x77,
+0x69,0x74,0x68,0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,
+0x2e,0x51,0x75,0x65,0x75,0x65,0x64,0x20,0x7d,0x7d,0x20,0x2b,
+0x7b,0x7b,0x20,0x2e,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6e,
+0x64,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x64,
+0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x74,0x69,0x70,
+0x73,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x61,
+0x62,0x6c,0x65,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x64,0x65,
+0x74,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,
+0x20,0x77,0x69,0x74,0x68,0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,
+0x65,0x72,0x2e,0x41,0x64,0x61,0x70,0x74,0x69,0x76,0x65,0x20,
+0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
+0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x74,0x68,0x3e,0x41,0x64,0x61,0x70,0x74,0x69,0x76,0x65,0x3a,
+0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x20,0x7d,
+0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,
+0x3e,0x51,0x75,0x65,0x75,0x65,0x64,0x3a,0x3c,0x2f,0x74,0x68,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
+0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,
+0x72,0x2e,0x51,0x75,0x65,0x75,0x65,0x64,0x20,0x7d,0x7d,0x7b,
+0x7b,0x20,0x77,0x69,0x74,0x68,0x20,0x2e,0x4c,0x69,0x6d,0x69,
+0x74,0x65,0x72,0x2e,0x51,0x75,0x65,0x75,0x65,0x4c,0x69,0x6d,
+0x69,0x74,0x20,0x7d,0x7d,0x20,0x6f,0x66,0x20,0x7b,0x7b,0x20,
+0x2e,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,
+0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x51,0x75,0x65,0x75,
+0x65,0x20,0x74,0x69,0x6d,0x65,0x6f,0x75,0x74,0x3a,0x3c,0x2f,
+0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x77,0x69,0x74,0x68,0x20,
+0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x51,0x75,0x65,
+0x75,0x65,0x54,0x69,0x6d,0x65,0x6f,0x75,0x74,0x20,0x7d,0x7d,
+0x7b,0x7b,0x20,0x2e,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6c,
+0x73,0x65,0x20,0x7d,0x7d,0x6e,0x6f,0x6e,0x65,0x7b,0x7b,0x20,
+0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,
+0x3e,0x41,0x76,0x67,0x20,0x77,0x61,0x69,0x74,0x3a,0x3c,0x2f,
+0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x4c,0x69,0x6d,0x69,
+0x74,0x65,0x72,0x2e,0x41,0x76,0x67,0x57,0x61,0x69,0x74,0x20,
+0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x4d,0x61,0x78,
+0x20,0x77,0x61,0x69,0x74,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
+0x7b,0x7b,0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,
+0x4d,0x61,0x78,0x57,0x61,0x69,0x74,0x20,0x7d,0x7d,0x3c,0x2f,
+0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x74,0x68,0x3e,0x44,0x72,0x6f,0x70,0x70,0x65,0x64,
+0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x4c,
+0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x44,0x72,0x6f,0x70,0x70,
+0x65,0x64,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x61,0x62,0x6c,
+0x65,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x64,0x69,
+0x76,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x2f,0x75,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,
+0x7d,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
+0x09,0x09,0x3c,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,
+0x75,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x24,
+0x63,0x6e,0x74,0x2e,0x54,0x6f,0x74,0x61,0x6c,0x53,0x65,0x73,
+0x73,0x69,0x6f,0x6e,0x73,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,
+0x3d,0x74,0x69,0x70,0x73,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x63,0x6c,0x61,0x73,
+0x73,0x3d,0x64,0x65,0x74,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x43,0x75,0x6d,0x2e,0x20,
+0x48,0x54,0x54,0x50,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,
+0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,
+0x63,0x6e,0x74,0x2e,0x54,0x6f,0x74,0x61,0x6c,0x53,0x65,0x73,
+0x73,0x69,0x6f,0x6e,0x73,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,
+0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
+0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x74,0x68,0x3e,0x2d,0x20,0x48,0x54,0x54,0x50,0x20,0x31,0x78,
 0x78,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,
 0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
 0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,
@@ -995,129 +1575,94 @@ var Stats_html = &bindata.Bindata{ []byte{
 0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x30,0x20,0x7d,0x7d,0x3c,
 0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
 0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
-0x3c,0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x09,0x09,0x09,
-0x09,0x09,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x7b,0x7b,0x20,0x77,0x69,0x74,0x68,0x20,0x24,0x63,0x6e,
+0x74,0x2e,0x47,0x72,0x70,0x63,0x45,0x72,0x72,0x6f,0x72,0x73,
+0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x74,0x68,0x3e,0x2d,0x20,0x67,0x52,0x50,0x43,0x20,0x65,
+0x72,0x72,0x6f,0x72,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
+0x7b,0x7b,0x20,0x2e,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,
+0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x3c,0x2f,0x75,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x2f,
+0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,
+0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,
+0x65,0x72,0x2e,0x4c,0x61,0x73,0x74,0x50,0x61,0x63,0x6b,0x65,
+0x74,0x20,0x7c,0x20,0x61,0x67,0x65,0x20,0x7d,0x7d,0x3c,0x2f,
+0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
+0x33,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,
+0x64,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x3e,0x30,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
+0x09,0x3c,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x75,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x39,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,
+0x3d,0x74,0x69,0x70,0x73,0x3e,0x43,0x6f,0x6e,0x6e,0x65,0x63,
+0x74,0x69,0x6f,0x6e,0x20,0x72,0x65,0x73,0x65,0x74,0x73,0x20,
+0x64,0x75,0x72,0x69,0x6e,0x67,0x20,0x74,0x72,0x61,0x6e,0x73,
+0x66,0x65,0x72,0x73,0x3a,0x20,0x35,0x36,0x31,0x20,0x63,0x6c,
+0x69,0x65,0x6e,0x74,0x2c,0x20,0x30,0x20,0x73,0x65,0x72,0x76,
+0x65,0x72,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x09,0x09,0x09,
 0x09,0x3c,0x2f,0x75,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,
-0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,
-0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,
-0x72,0x2e,0x4c,0x61,0x73,0x74,0x50,0x61,0x63,0x6b,0x65,0x74,
-0x20,0x7c,0x20,0x61,0x67,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x74,
-0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x58,0x58,
-0x58,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,
-0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x58,0x58,0x58,0x0a,0x09,
-0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
-0x74,0x64,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
-0x3c,0x74,0x64,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
-0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
-0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x3c,0x2f,0x74,0x61,
-0x62,0x6c,0x65,0x3e,0x0a,0x09,0x3c,0x62,0x72,0x3e,0x0a,0x09,
-0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x20,0x3c,0x21,
-0x2d,0x2d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x2e,0x46,0x72,
-0x6f,0x6e,0x74,0x65,0x6e,0x64,0x20,0x2d,0x2d,0x3e,0x0a,0x09,
-0x7b,0x7b,0x72,0x61,0x6e,0x67,0x65,0x20,0x2e,0x42,0x61,0x63,
-0x6b,0x65,0x6e,0x64,0x73,0x7d,0x7d,0x0a,0x09,0x3c,0x74,0x61,
-0x62,0x6c,0x65,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,
-0x62,0x6c,0x22,0x20,0x77,0x69,0x64,0x74,0x68,0x3d,0x22,0x31,
-0x30,0x30,0x25,0x22,0x3e,0x0a,0x09,0x09,0x3c,0x74,0x72,0x20,
-0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x69,0x74,0x72,0x65,
-0x22,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x20,0x63,0x6c,
-0x61,0x73,0x73,0x3d,0x22,0x70,0x78,0x6e,0x61,0x6d,0x65,0x22,
-0x20,0x77,0x69,0x64,0x74,0x68,0x3d,0x22,0x31,0x30,0x25,0x22,
+0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x30,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
+0x30,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,
+0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,0x3e,0x7b,
+0x7b,0x20,0x2e,0x48,0x65,0x61,0x6c,0x74,0x68,0x43,0x68,0x65,
+0x63,0x6b,0x65,0x72,0x2e,0x4c,0x61,0x73,0x74,0x53,0x74,0x61,
+0x74,0x75,0x73,0x43,0x68,0x61,0x6e,0x67,0x65,0x20,0x7c,0x20,
+0x61,0x67,0x65,0x20,0x7d,0x7d,0x20,0x7b,0x7b,0x20,0x69,0x66,
+0x20,0x2e,0x48,0x65,0x61,0x6c,0x74,0x68,0x43,0x68,0x65,0x63,
+0x6b,0x65,0x72,0x2e,0x49,0x73,0x48,0x65,0x61,0x6c,0x74,0x68,
+0x79,0x20,0x7d,0x7d,0x55,0x50,0x7b,0x7b,0x20,0x65,0x6c,0x73,
+0x65,0x20,0x7d,0x7d,0x44,0x4f,0x57,0x4e,0x7b,0x7b,0x20,0x65,
+0x6e,0x64,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
+0x09,0x09,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,
+0x61,0x63,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x2e,0x48,0x65,0x61,
+0x6c,0x74,0x68,0x43,0x68,0x65,0x63,0x6b,0x65,0x72,0x2e,0x48,
+0x65,0x61,0x6c,0x74,0x68,0x53,0x74,0x61,0x74,0x75,0x73,0x20,
+0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x3c,0x2f,0x75,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
+0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,
+0x3e,0x31,0x30,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
+0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,
+0x3e,0x59,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,0x3e,
+0x2d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,
+0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x38,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x74,0x69,
+0x70,0x73,0x3e,0x46,0x61,0x69,0x6c,0x65,0x64,0x20,0x48,0x65,
+0x61,0x6c,0x74,0x68,0x20,0x43,0x68,0x65,0x63,0x6b,0x73,0x3c,
+0x2f,0x64,0x69,0x76,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x2f,
+0x75,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x33,0x3c,0x2f,0x74,0x64,
+0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x31,0x36,0x6d,
+0x35,0x31,0x73,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
+0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,
+0x3e,0x2d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x3c,0x2f,
+0x74,0x72,0x3e,0x0a,0x09,0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,
+0x20,0x7d,0x7d,0x0a,0x09,0x09,0x3c,0x74,0x72,0x20,0x63,0x6c,
+0x61,0x73,0x73,0x3d,0x22,0x62,0x61,0x63,0x6b,0x65,0x6e,0x64,
+0x22,0x3e,0x0a,0x09,0x09,0x09,0x7b,0x7b,0x20,0x24,0x63,0x6e,
+0x74,0x20,0x3a,0x3d,0x20,0x2e,0x47,0x65,0x74,0x43,0x6f,0x75,
+0x6e,0x74,0x65,0x72,0x73,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,
+0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,
 0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x61,0x20,0x6e,0x61,0x6d,
-0x65,0x3d,0x22,0x73,0x74,0x61,0x74,0x73,0x22,0x3e,0x3c,0x2f,
-0x61,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x61,0x20,0x63,0x6c,
-0x61,0x73,0x73,0x3d,0x70,0x78,0x20,0x68,0x72,0x65,0x66,0x3d,
-0x22,0x23,0x73,0x74,0x61,0x74,0x73,0x22,0x3e,0x42,0x61,0x63,
-0x6b,0x65,0x6e,0x64,0x20,0x7b,0x7b,0x20,0x2e,0x43,0x66,0x2e,
-0x4e,0x61,0x6d,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x61,0x3e,0x0a,
-0x09,0x09,0x09,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,
-0x3c,0x74,0x68,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x65,
-0x6d,0x70,0x74,0x79,0x22,0x20,0x77,0x69,0x64,0x74,0x68,0x3d,
-0x22,0x39,0x30,0x25,0x22,0x3e,0x3c,0x2f,0x74,0x68,0x3e,0x0a,
-0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x3c,0x2f,0x74,
-0x61,0x62,0x6c,0x65,0x3e,0x0a,0x0a,0x09,0x3c,0x74,0x61,0x62,
-0x6c,0x65,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x62,
-0x6c,0x22,0x20,0x77,0x69,0x64,0x74,0x68,0x3d,0x22,0x31,0x30,
-0x30,0x25,0x22,0x3e,0x0a,0x09,0x09,0x3c,0x74,0x72,0x20,0x63,
-0x6c,0x61,0x73,0x73,0x3d,0x22,0x74,0x69,0x74,0x72,0x65,0x22,
-0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x20,0x72,0x6f,0x77,
-0x73,0x70,0x61,0x6e,0x3d,0x32,0x3e,0x3c,0x2f,0x74,0x68,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x20,0x63,0x6f,0x6c,0x73,
-0x70,0x61,0x6e,0x3d,0x34,0x3e,0x52,0x65,0x71,0x75,0x65,0x73,
-0x74,0x73,0x20,0x72,0x61,0x74,0x65,0x3c,0x2f,0x74,0x68,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x20,0x63,0x6f,0x6c,0x73,
-0x70,0x61,0x6e,0x3d,0x35,0x3e,0x52,0x65,0x71,0x75,0x65,0x73,
-0x74,0x73,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,
-0x74,0x68,0x20,0x63,0x6f,0x6c,0x73,0x70,0x61,0x6e,0x3d,0x32,
-0x3e,0x44,0x65,0x6e,0x69,0x65,0x64,0x3c,0x2f,0x74,0x68,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x20,0x63,0x6f,0x6c,0x73,
-0x70,0x61,0x6e,0x3d,0x33,0x3e,0x45,0x72,0x72,0x6f,0x72,0x73,
-0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,
-0x20,0x63,0x6f,0x6c,0x73,0x70,0x61,0x6e,0x3d,0x32,0x3e,0x57,
-0x61,0x72,0x6e,0x69,0x6e,0x67,0x73,0x3c,0x2f,0x74,0x68,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x20,0x63,0x6f,0x6c,0x73,
-0x70,0x61,0x6e,0x3d,0x39,0x3e,0x53,0x65,0x72,0x76,0x65,0x72,
-0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x3c,0x2f,0x74,0x72,
-0x3e,0x0a,0x09,0x09,0x3c,0x74,0x72,0x20,0x63,0x6c,0x61,0x73,
-0x73,0x3d,0x22,0x74,0x69,0x74,0x72,0x65,0x22,0x3e,0x0a,0x09,
-0x09,0x09,0x3c,0x74,0x68,0x3e,0x43,0x75,0x72,0x3c,0x2f,0x74,
-0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x4d,0x61,
-0x78,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,
-0x68,0x3e,0x4c,0x69,0x6d,0x69,0x74,0x3c,0x2f,0x74,0x68,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x44,0x65,0x6e,0x69,
-0x65,0x64,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,
-0x74,0x68,0x3e,0x43,0x75,0x72,0x3c,0x2f,0x74,0x68,0x3e,0x0a,
-0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x4d,0x61,0x78,0x3c,0x2f,
-0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x4c,
-0x69,0x6d,0x69,0x74,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,
-0x09,0x3c,0x74,0x68,0x3e,0x54,0x6f,0x74,0x61,0x6c,0x3c,0x2f,
-0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x4c,
-0x61,0x73,0x74,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,
-0x3c,0x74,0x68,0x3e,0x52,0x65,0x71,0x3c,0x2f,0x74,0x68,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x52,0x65,0x73,0x70,
-0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,
-0x3e,0x52,0x65,0x71,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,
-0x09,0x3c,0x74,0x68,0x3e,0x43,0x6f,0x6e,0x6e,0x3c,0x2f,0x74,
-0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x52,0x65,
-0x73,0x70,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,
-0x74,0x68,0x3e,0x52,0x65,0x74,0x72,0x3c,0x2f,0x74,0x68,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x52,0x65,0x64,0x69,
-0x73,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,
-0x68,0x3e,0x53,0x74,0x61,0x74,0x75,0x73,0x3c,0x2f,0x74,0x68,
-0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x4c,0x61,0x73,
-0x74,0x43,0x68,0x6b,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,
-0x09,0x3c,0x74,0x68,0x3e,0x57,0x67,0x68,0x74,0x3c,0x2f,0x74,
-0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x41,0x63,
-0x74,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,
-0x68,0x3e,0x42,0x63,0x6b,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,
-0x09,0x09,0x3c,0x74,0x68,0x3e,0x43,0x68,0x6b,0x3c,0x2f,0x74,
-0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x44,0x77,
-0x6e,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,
-0x68,0x3e,0x44,0x77,0x6e,0x74,0x6d,0x65,0x3c,0x2f,0x74,0x68,
-0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x54,0x68,0x72,
-0x74,0x6c,0x65,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x3c,
-0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x7b,0x7b,0x20,0x72,0x61,
-0x6e,0x67,0x65,0x20,0x2e,0x53,0x65,0x72,0x76,0x65,0x72,0x73,
-0x7d,0x7d,0x0a,0x09,0x09,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,
-0x20,0x3a,0x3d,0x20,0x2e,0x47,0x65,0x74,0x43,0x6f,0x75,0x6e,
-0x74,0x65,0x72,0x73,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x3c,0x74,
-0x72,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x22,0x7b,0x7b,0x20,
-0x69,0x66,0x20,0x2e,0x48,0x65,0x61,0x6c,0x74,0x68,0x43,0x68,
-0x65,0x63,0x6b,0x65,0x72,0x2e,0x49,0x73,0x48,0x65,0x61,0x6c,
-0x74,0x68,0x79,0x20,0x7d,0x7d,0x61,0x63,0x74,0x69,0x76,0x65,
-0x34,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x61,
-0x63,0x74,0x69,0x76,0x65,0x30,0x7b,0x7b,0x20,0x65,0x6e,0x64,
-0x20,0x7d,0x7d,0x22,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,
-0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,0x3e,0x0a,0x09,
-0x09,0x09,0x09,0x3c,0x61,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,
-0x70,0x61,0x67,0x65,0x32,0x72,0x73,0x73,0x2f,0x68,0x32,0x22,
-0x3e,0x3c,0x2f,0x61,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x61,
-0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x6c,0x66,0x73,0x62,0x20,
-0x68,0x72,0x65,0x66,0x3d,0x22,0x23,0x70,0x61,0x67,0x65,0x32,
-0x72,0x73,0x73,0x2f,0x68,0x32,0x22,0x3e,0x7b,0x7b,0x20,0x2e,
-0x43,0x66,0x2e,0x41,0x64,0x64,0x72,0x65,0x73,0x73,0x20,0x7d,
-0x7d,0x3c,0x2f,0x61,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,
-0x64,0x3e,0x0a,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,
+0x65,0x3d,0x22,0x70,0x61,0x67,0x65,0x32,0x72,0x73,0x73,0x2f,
+0x42,0x61,0x63,0x6b,0x65,0x6e,0x64,0x22,0x3e,0x3c,0x2f,0x61,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x61,0x20,0x63,0x6c,0x61,
+0x73,0x73,0x3d,0x6c,0x66,0x73,0x62,0x20,0x68,0x72,0x65,0x66,
+0x3d,0x22,0x23,0x70,0x61,0x67,0x65,0x32,0x72,0x73,0x73,0x2f,
+0x42,0x61,0x63,0x6b,0x65,0x6e,0x64,0x22,0x3e,0x54,0x6f,0x74,
+0x61,0x6c,0x20,0x66,0x6f,0x72,0x20,0x62,0x61,0x63,0x6b,0x65,
+0x6e,0x64,0x3c,0x2f,0x61,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x2f,
+0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,
 0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,
 0x65,0x72,0x2e,0x43,0x75,0x72,0x72,0x65,0x6e,0x74,0x51,0x50,
 0x53,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
@@ -1125,342 +1670,293 @@ var Stats_html = &bindata.Bindata{ []byte{
 0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4d,0x61,0x78,
 0x51,0x50,0x53,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
 0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x66,
-0x20,0x67,0x74,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,
-0x69,0x74,0x65,0x72,0x2e,0x54,0x61,0x72,0x67,0x65,0x74,0x51,
-0x50,0x53,0x20,0x39,0x39,0x39,0x39,0x39,0x39,0x20,0x7d,0x7d,
-0xe2,0x88,0x9e,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,
-0x7d,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,
-0x69,0x74,0x65,0x72,0x2e,0x54,0x61,0x72,0x67,0x65,0x74,0x51,
-0x50,0x53,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,
-0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
-0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,
-0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x54,0x6f,0x74,0x61,0x6c,
-0x52,0x65,0x6a,0x65,0x63,0x74,0x65,0x64,0x43,0x6f,0x75,0x6e,
-0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x0a,0x09,
-0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,0x6e,
-0x74,0x2e,0x43,0x75,0x72,0x41,0x63,0x74,0x69,0x76,0x65,0x53,
-0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,0x7d,0x7d,0x3c,0x2f,
-0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,
-0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,0x4d,0x61,0x78,0x41,0x63,
-0x74,0x69,0x76,0x65,0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,
-0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
-0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x66,0x20,0x65,0x71,
-0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,0x69,
-0x6d,0x69,0x74,0x20,0x30,0x20,0x7d,0x7d,0xe2,0x88,0x9e,0x7b,
-0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x7b,0x7b,0x20,
-0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,0x69,0x6d,
-0x69,0x74,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,
-0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
-0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,
-0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,
-0x2e,0x54,0x6f,0x74,0x61,0x6c,0x53,0x65,0x73,0x73,0x69,0x6f,
-0x6e,0x73,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,
-0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x74,0x69,
-0x70,0x73,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
-0x61,0x62,0x6c,0x65,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x64,
-0x65,0x74,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
-0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
-0x3c,0x74,0x68,0x3e,0x43,0x75,0x6d,0x2e,0x20,0x48,0x54,0x54,
-0x50,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x73,0x3a,0x3c,
-0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
-0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,
-0x2e,0x54,0x6f,0x74,0x61,0x6c,0x53,0x65,0x73,0x73,0x69,0x6f,
+0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,
+0x72,0x2e,0x55,0x6e,0x6c,0x69,0x6d,0x69,0x74,0x65,0x64,0x20,
+0x7d,0x7d,0xe2,0x88,0x9e,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,
+0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,
+0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x54,0x61,0x72,0x67,0x65,
+0x74,0x51,0x50,0x53,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6e,
+0x64,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
+0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,
+0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x54,0x6f,0x74,
+0x61,0x6c,0x52,0x65,0x6a,0x65,0x63,0x74,0x65,0x64,0x43,0x6f,
+0x75,0x6e,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
+0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,
+0x63,0x6e,0x74,0x2e,0x43,0x75,0x72,0x41,0x63,0x74,0x69,0x76,
+0x65,0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,0x7d,0x7d,
+0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,
+0x3e,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,0x4d,0x61,0x78,
+0x41,0x63,0x74,0x69,0x76,0x65,0x53,0x65,0x73,0x73,0x69,0x6f,
 0x6e,0x73,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
+0x09,0x09,0x3c,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x7b,
+0x7b,0x20,0x69,0x66,0x20,0x65,0x71,0x20,0x2e,0x4c,0x69,0x6d,
+0x69,0x74,0x65,0x72,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x20,0x30,
+0x20,0x7d,0x7d,0xe2,0x88,0x9e,0x7b,0x7b,0x20,0x65,0x6c,0x73,
+0x65,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x3c,0x75,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x69,0x66,0x20,
+0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x41,0x64,0x61,
+0x70,0x74,0x69,0x76,0x65,0x20,0x7d,0x7d,0x7e,0x7b,0x7b,0x20,
+0x65,0x6e,0x64,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x2e,0x4c,0x69,
+0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x20,
+0x7d,0x7d,0x7b,0x7b,0x20,0x77,0x69,0x74,0x68,0x20,0x2e,0x4c,
+0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x51,0x75,0x65,0x75,0x65,
+0x64,0x20,0x7d,0x7d,0x20,0x2b,0x7b,0x7b,0x20,0x2e,0x20,0x7d,
+0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,
+0x73,0x73,0x3d,0x74,0x69,0x70,0x73,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x74,0x61,0x62,0x6c,0x65,0x20,0x63,0x6c,
+0x61,0x73,0x73,0x3d,0x64,0x65,0x74,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x77,0x69,0x74,0x68,0x20,
+0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x41,0x64,0x61,
+0x70,0x74,0x69,0x76,0x65,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x41,0x64,0x61,
+0x70,0x74,0x69,0x76,0x65,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
+0x7b,0x7b,0x20,0x2e,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,
+0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x51,0x75,0x65,0x75,0x65,
+0x64,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,
+0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x51,0x75,0x65,0x75,
+0x65,0x64,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x77,0x69,0x74,0x68,
+0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x51,0x75,
+0x65,0x75,0x65,0x4c,0x69,0x6d,0x69,0x74,0x20,0x7d,0x7d,0x20,
+0x6f,0x66,0x20,0x7b,0x7b,0x20,0x2e,0x20,0x7d,0x7d,0x7b,0x7b,
+0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
+0x68,0x3e,0x51,0x75,0x65,0x75,0x65,0x20,0x74,0x69,0x6d,0x65,
+0x6f,0x75,0x74,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,
+0x20,0x77,0x69,0x74,0x68,0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,
+0x65,0x72,0x2e,0x51,0x75,0x65,0x75,0x65,0x54,0x69,0x6d,0x65,
+0x6f,0x75,0x74,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x2e,0x20,0x7d,
+0x7d,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x6e,
+0x6f,0x6e,0x65,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,
+0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x41,0x76,0x67,0x20,0x77,
+0x61,0x69,0x74,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,
+0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x41,0x76,
+0x67,0x57,0x61,0x69,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,
+0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
+0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x74,0x68,0x3e,0x4d,0x61,0x78,0x20,0x77,0x61,0x69,0x74,0x3a,
+0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x4c,0x69,
+0x6d,0x69,0x74,0x65,0x72,0x2e,0x4d,0x61,0x78,0x57,0x61,0x69,
+0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x44,
+0x72,0x6f,0x70,0x70,0x65,0x64,0x3a,0x3c,0x2f,0x74,0x68,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,
+0x3e,0x7b,0x7b,0x20,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,
+0x2e,0x44,0x72,0x6f,0x70,0x70,0x65,0x64,0x20,0x7d,0x7d,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x3c,0x2f,0x75,0x3e,0x0a,0x09,0x09,0x09,0x09,0x7b,0x7b,
+0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,0x54,0x6f,
+0x74,0x61,0x6c,0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,
+0x7d,0x7d,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x64,0x69,0x76,
+0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x74,0x69,0x70,0x73,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x61,0x62,0x6c,
+0x65,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x64,0x65,0x74,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,
+0x3e,0x43,0x75,0x6d,0x2e,0x20,0x48,0x54,0x54,0x50,0x20,0x72,
+0x65,0x71,0x75,0x65,0x73,0x74,0x73,0x3a,0x3c,0x2f,0x74,0x68,
+0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
+0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,0x54,0x6f,
+0x74,0x61,0x6c,0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,
+0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,0x48,
+0x54,0x54,0x50,0x20,0x31,0x78,0x78,0x20,0x72,0x65,0x73,0x70,
+0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
+0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,0x24,0x63,0x6e,
+0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x42,0x79,
+0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,
+0x20,0x31,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
 0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,
 0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,
 0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,
-0x2d,0x20,0x48,0x54,0x54,0x50,0x20,0x31,0x78,0x78,0x20,0x72,
+0x2d,0x20,0x48,0x54,0x54,0x50,0x20,0x32,0x78,0x78,0x20,0x72,
 0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,
 0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
 0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,
 0x24,0x63,0x6e,0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,
 0x73,0x42,0x79,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,
-0x6f,0x64,0x65,0x20,0x31,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,
+0x6f,0x64,0x65,0x20,0x32,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,
 0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,
 0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
 0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
-0x74,0x68,0x3e,0x2d,0x20,0x48,0x54,0x54,0x50,0x20,0x32,0x78,
+0x74,0x68,0x3e,0x2d,0x20,0x48,0x54,0x54,0x50,0x20,0x33,0x78,
 0x78,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,
 0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
 0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,
 0x65,0x78,0x20,0x24,0x63,0x6e,0x74,0x2e,0x43,0x6f,0x75,0x6e,
 0x74,0x65,0x72,0x73,0x42,0x79,0x52,0x65,0x73,0x70,0x6f,0x6e,
-0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x32,0x20,0x7d,0x7d,0x3c,
+0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x33,0x20,0x7d,0x7d,0x3c,
 0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
 0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
 0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
 0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,0x48,0x54,0x54,0x50,
-0x20,0x33,0x78,0x78,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,
+0x20,0x34,0x78,0x78,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,
 0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,
 0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,
 0x69,0x6e,0x64,0x65,0x78,0x20,0x24,0x63,0x6e,0x74,0x2e,0x43,
 0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x42,0x79,0x52,0x65,0x73,
-0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x33,0x20,
+0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x34,0x20,
 0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,
 0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,
 0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,
 0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,0x48,
-0x54,0x54,0x50,0x20,0x34,0x78,0x78,0x20,0x72,0x65,0x73,0x70,
+0x54,0x54,0x50,0x20,0x35,0x78,0x78,0x20,0x72,0x65,0x73,0x70,
 0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,
 0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
 0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,0x24,0x63,0x6e,
 0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x42,0x79,
 0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,
-0x20,0x34,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
+0x20,0x35,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
 0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,
 0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,
 0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,
-0x2d,0x20,0x48,0x54,0x54,0x50,0x20,0x35,0x78,0x78,0x20,0x72,
-0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,
-0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
-0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,
-0x24,0x63,0x6e,0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,
-0x73,0x42,0x79,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,
-0x6f,0x64,0x65,0x20,0x35,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,
-0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,
-0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
-0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
-0x74,0x68,0x3e,0x2d,0x20,0x6f,0x74,0x68,0x65,0x72,0x20,0x72,
-0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,
-0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
-0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,
-0x24,0x63,0x6e,0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,
-0x73,0x42,0x79,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,
-0x6f,0x64,0x65,0x20,0x30,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,
-0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,
-0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,
-0x61,0x62,0x6c,0x65,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,
-0x2f,0x64,0x69,0x76,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x2f,
-0x75,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
-0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,
-0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,
-0x61,0x73,0x74,0x50,0x61,0x63,0x6b,0x65,0x74,0x20,0x7c,0x20,
-0x61,0x67,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
-0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,0x74,0x64,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x33,0x3c,0x2f,0x74,
+0x2d,0x20,0x6f,0x74,0x68,0x65,0x72,0x20,0x72,0x65,0x73,0x70,
+0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
+0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,0x24,0x63,0x6e,
+0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x42,0x79,
+0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,
+0x20,0x30,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x7b,0x7b,0x20,0x77,0x69,
+0x74,0x68,0x20,0x24,0x63,0x6e,0x74,0x2e,0x47,0x72,0x70,0x63,
+0x45,0x72,0x72,0x6f,0x72,0x73,0x20,0x7d,0x7d,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,
+0x67,0x52,0x50,0x43,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x3a,
+0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x20,0x7d,
+0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
+0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x09,0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,
+0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x61,0x62,
+0x6c,0x65,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x64,
+0x69,0x76,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x2f,0x75,0x3e,
+0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
+0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,
+0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,0x61,0x73,
+0x74,0x50,0x61,0x63,0x6b,0x65,0x74,0x20,0x7c,0x20,0x61,0x67,
+0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
+0x09,0x3c,0x74,0x64,0x3e,0x31,0x37,0x3c,0x2f,0x74,0x64,0x3e,
+0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x37,0x3c,0x2f,0x74,
 0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,
-0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x30,
-0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,
-0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,0x09,0x09,
-0x09,0x09,0x09,0x39,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x64,
-0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x74,0x69,0x70,
-0x73,0x3e,0x43,0x6f,0x6e,0x6e,0x65,0x63,0x74,0x69,0x6f,0x6e,
-0x20,0x72,0x65,0x73,0x65,0x74,0x73,0x20,0x64,0x75,0x72,0x69,
-0x6e,0x67,0x20,0x74,0x72,0x61,0x6e,0x73,0x66,0x65,0x72,0x73,
-0x3a,0x20,0x35,0x36,0x31,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,
-0x2c,0x20,0x30,0x20,0x73,0x65,0x72,0x76,0x65,0x72,0x3c,0x2f,
-0x64,0x69,0x76,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x2f,0x75,
-0x3e,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
-0x09,0x09,0x3c,0x74,0x64,0x3e,0x30,0x3c,0x2f,0x74,0x64,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x30,0x3c,0x2f,0x74,
-0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,0x63,0x6c,
-0x61,0x73,0x73,0x3d,0x61,0x63,0x3e,0x7b,0x7b,0x20,0x2e,0x48,
-0x65,0x61,0x6c,0x74,0x68,0x43,0x68,0x65,0x63,0x6b,0x65,0x72,
-0x2e,0x4c,0x61,0x73,0x74,0x53,0x74,0x61,0x74,0x75,0x73,0x43,
-0x68,0x61,0x6e,0x67,0x65,0x20,0x7c,0x20,0x61,0x67,0x65,0x20,
-0x7d,0x7d,0x20,0x7b,0x7b,0x20,0x69,0x66,0x20,0x2e,0x48,0x65,
-0x61,0x6c,0x74,0x68,0x43,0x68,0x65,0x63,0x6b,0x65,0x72,0x2e,
-0x49,0x73,0x48,0x65,0x61,0x6c,0x74,0x68,0x79,0x20,0x7d,0x7d,
-0x55,0x50,0x7b,0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,
-0x44,0x4f,0x57,0x4e,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,
-0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,
-0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,0x3e,0x0a,
-0x09,0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,0x09,0x09,0x09,0x09,
-0x09,0x7b,0x7b,0x20,0x2e,0x48,0x65,0x61,0x6c,0x74,0x68,0x43,
-0x68,0x65,0x63,0x6b,0x65,0x72,0x2e,0x48,0x65,0x61,0x6c,0x74,
-0x68,0x53,0x74,0x61,0x74,0x75,0x73,0x20,0x7d,0x7d,0x0a,0x09,
-0x09,0x09,0x09,0x3c,0x2f,0x75,0x3e,0x0a,0x09,0x09,0x09,0x3c,
-0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,
-0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,0x3e,0x31,0x30,0x3c,
-0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,
-0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,0x3e,0x59,0x3c,0x2f,
+0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x32,
+0x32,0x32,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x33,0x32,0x0a,0x09,0x09,0x09,0x09,
+0x09,0x3c,0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,
+0x74,0x69,0x70,0x73,0x3e,0x43,0x6f,0x6e,0x6e,0x65,0x63,0x74,
+0x69,0x6f,0x6e,0x20,0x72,0x65,0x73,0x65,0x74,0x73,0x20,0x64,
+0x75,0x72,0x69,0x6e,0x67,0x20,0x74,0x72,0x61,0x6e,0x73,0x66,
+0x65,0x72,0x73,0x3a,0x20,0x31,0x36,0x35,0x31,0x20,0x63,0x6c,
+0x69,0x65,0x6e,0x74,0x2c,0x20,0x30,0x20,0x73,0x65,0x72,0x76,
+0x65,0x72,0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x09,0x09,0x09,
+0x09,0x3c,0x2f,0x75,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,
+0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x30,0x3c,
+0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
+0x30,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,
+0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,0x3e,0x36,
+0x64,0x35,0x68,0x20,0x55,0x50,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,
+0x3d,0x61,0x63,0x3e,0x26,0x6e,0x62,0x73,0x70,0x3b,0x3c,0x2f,
 0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,0x63,
-0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,0x3e,0x2d,0x3c,0x2f,0x74,
-0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x0a,0x09,
-0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
-0x38,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x64,0x69,0x76,0x20,
-0x63,0x6c,0x61,0x73,0x73,0x3d,0x74,0x69,0x70,0x73,0x3e,0x46,
-0x61,0x69,0x6c,0x65,0x64,0x20,0x48,0x65,0x61,0x6c,0x74,0x68,
-0x20,0x43,0x68,0x65,0x63,0x6b,0x73,0x3c,0x2f,0x64,0x69,0x76,
-0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x2f,0x75,0x3e,0x0a,0x09,
-0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
-0x74,0x64,0x3e,0x33,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
-0x09,0x3c,0x74,0x64,0x3e,0x31,0x36,0x6d,0x35,0x31,0x73,0x3c,
-0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,
-0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,0x3e,0x2d,0x3c,0x2f,
+0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,0x3e,0x33,0x30,0x3c,0x2f,
+0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,0x63,
+0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,0x3e,0x33,0x3c,0x2f,0x74,
+0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,0x63,0x6c,
+0x61,0x73,0x73,0x3d,0x61,0x63,0x3e,0x30,0x3c,0x2f,0x74,0x64,
+0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,
+0x73,0x73,0x3d,0x61,0x63,0x3e,0x26,0x6e,0x62,0x73,0x70,0x3b,
+0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,
+0x3e,0x31,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x3e,0x31,0x31,0x6d,0x34,0x38,0x73,0x3c,0x2f,0x74,
+0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,
 0x74,0x64,0x3e,0x0a,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,
-0x09,0x09,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,
-0x09,0x09,0x3c,0x74,0x72,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,
-0x22,0x62,0x61,0x63,0x6b,0x65,0x6e,0x64,0x22,0x3e,0x0a,0x09,
-0x09,0x09,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x20,0x3a,0x3d,
-0x20,0x2e,0x47,0x65,0x74,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,
-0x73,0x20,0x7d,0x7d,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,
-0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,0x3e,0x0a,0x09,0x09,
-0x09,0x09,0x3c,0x61,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x70,
-0x61,0x67,0x65,0x32,0x72,0x73,0x73,0x2f,0x42,0x61,0x63,0x6b,
-0x65,0x6e,0x64,0x22,0x3e,0x3c,0x2f,0x61,0x3e,0x0a,0x09,0x09,
-0x09,0x09,0x3c,0x61,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x6c,
-0x66,0x73,0x62,0x20,0x68,0x72,0x65,0x66,0x3d,0x22,0x23,0x70,
-0x61,0x67,0x65,0x32,0x72,0x73,0x73,0x2f,0x42,0x61,0x63,0x6b,
-0x65,0x6e,0x64,0x22,0x3e,0x54,0x6f,0x74,0x61,0x6c,0x20,0x66,
-0x6f,0x72,0x20,0x62,0x61,0x63,0x6b,0x65,0x6e,0x64,0x3c,0x2f,
-0x61,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
-0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,
-0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x43,
-0x75,0x72,0x72,0x65,0x6e,0x74,0x51,0x50,0x53,0x20,0x7d,0x7d,
+0x09,0x09,0x7b,0x7b,0x20,0x77,0x69,0x74,0x68,0x20,0x2e,0x55,
+0x70,0x67,0x72,0x61,0x64,0x65,0x73,0x20,0x7d,0x7d,0x7b,0x7b,
+0x20,0x77,0x69,0x74,0x68,0x20,0x2e,0x47,0x65,0x74,0x43,0x6f,
+0x75,0x6e,0x74,0x65,0x72,0x73,0x20,0x7d,0x7d,0x7b,0x7b,0x20,
+0x69,0x66,0x20,0x2e,0x54,0x6f,0x74,0x61,0x6c,0x20,0x7d,0x7d,
+0x0a,0x09,0x09,0x3c,0x74,0x72,0x20,0x63,0x6c,0x61,0x73,0x73,
+0x3d,0x22,0x62,0x61,0x63,0x6b,0x65,0x6e,0x64,0x22,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,
+0x3d,0x61,0x63,0x3e,0x55,0x70,0x67,0x72,0x61,0x64,0x65,0x64,
+0x20,0x63,0x6f,0x6e,0x6e,0x65,0x63,0x74,0x69,0x6f,0x6e,0x73,
 0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,
-0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,
-0x69,0x74,0x65,0x72,0x2e,0x4d,0x61,0x78,0x51,0x50,0x53,0x20,
-0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
-0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x66,0x20,0x67,0x74,0x20,
-0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,
-0x2e,0x54,0x61,0x72,0x67,0x65,0x74,0x51,0x50,0x53,0x20,0x39,
-0x39,0x39,0x39,0x39,0x39,0x20,0x7d,0x7d,0xe2,0x88,0x9e,0x7b,
-0x7b,0x20,0x65,0x6c,0x73,0x65,0x20,0x7d,0x7d,0x7b,0x7b,0x20,
-0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,0x65,0x72,
-0x2e,0x54,0x61,0x72,0x67,0x65,0x74,0x51,0x50,0x53,0x20,0x7d,
-0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3c,0x2f,
-0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,
-0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,0x69,0x6d,0x69,0x74,
-0x65,0x72,0x2e,0x54,0x6f,0x74,0x61,0x6c,0x52,0x65,0x6a,0x65,
-0x63,0x74,0x65,0x64,0x43,0x6f,0x75,0x6e,0x74,0x20,0x7d,0x7d,
-0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x0a,0x09,0x09,0x09,0x3c,0x74,
-0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,0x43,0x75,
-0x72,0x41,0x63,0x74,0x69,0x76,0x65,0x53,0x65,0x73,0x73,0x69,
-0x6f,0x6e,0x73,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
-0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x24,0x63,
-0x6e,0x74,0x2e,0x4d,0x61,0x78,0x41,0x63,0x74,0x69,0x76,0x65,
-0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,0x7d,0x7d,0x3c,
+0x20,0x63,0x6f,0x6c,0x73,0x70,0x61,0x6e,0x3d,0x34,0x3e,0x3c,
 0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,
-0x7b,0x7b,0x20,0x69,0x66,0x20,0x65,0x71,0x20,0x2e,0x4c,0x69,
-0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x20,
-0x30,0x20,0x7d,0x7d,0xe2,0x88,0x9e,0x7b,0x7b,0x20,0x65,0x6c,
-0x73,0x65,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x2e,0x4c,0x69,0x6d,
-0x69,0x74,0x65,0x72,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x20,0x7d,
-0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x3c,0x2f,
+0x7b,0x7b,0x20,0x2e,0x43,0x75,0x72,0x41,0x63,0x74,0x69,0x76,
+0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
+0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x4d,0x61,0x78,
+0x41,0x63,0x74,0x69,0x76,0x65,0x20,0x7d,0x7d,0x3c,0x2f,0x74,
+0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,
 0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x0a,
 0x09,0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,0x09,0x09,0x09,0x09,
-0x09,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,0x54,0x6f,0x74,
-0x61,0x6c,0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,0x7d,
+0x09,0x7b,0x7b,0x20,0x2e,0x54,0x6f,0x74,0x61,0x6c,0x20,0x7d,
 0x7d,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x64,0x69,0x76,0x20,
 0x63,0x6c,0x61,0x73,0x73,0x3d,0x74,0x69,0x70,0x73,0x3e,0x0a,
 0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x61,0x62,0x6c,0x65,
 0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x64,0x65,0x74,0x3e,0x0a,
 0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,
 0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,
-0x43,0x75,0x6d,0x2e,0x20,0x48,0x54,0x54,0x50,0x20,0x72,0x65,
-0x71,0x75,0x65,0x73,0x74,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,
-0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,
-0x3e,0x7b,0x7b,0x20,0x24,0x63,0x6e,0x74,0x2e,0x54,0x6f,0x74,
-0x61,0x6c,0x53,0x65,0x73,0x73,0x69,0x6f,0x6e,0x73,0x20,0x7d,
-0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
-0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,
-0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,
-0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,0x48,0x54,
-0x54,0x50,0x20,0x31,0x78,0x78,0x20,0x72,0x65,0x73,0x70,0x6f,
-0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,
-0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,
-0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,0x24,0x63,0x6e,0x74,
-0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x42,0x79,0x52,
-0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,
-0x31,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
-0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,
-0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,
-0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,
-0x20,0x48,0x54,0x54,0x50,0x20,0x32,0x78,0x78,0x20,0x72,0x65,
-0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,
-0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
-0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,0x24,
-0x63,0x6e,0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,
-0x42,0x79,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,
-0x64,0x65,0x20,0x32,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,
+0x42,0x79,0x74,0x65,0x73,0x20,0x69,0x6e,0x3a,0x3c,0x2f,0x74,
+0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
+0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x42,0x79,0x74,0x65,0x73,
+0x49,0x6e,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,
+0x42,0x79,0x74,0x65,0x73,0x20,0x6f,0x75,0x74,0x3a,0x3c,0x2f,
+0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
+0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x42,0x79,0x74,0x65,
+0x73,0x4f,0x75,0x74,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,
 0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,
 0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,
 0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,
-0x68,0x3e,0x2d,0x20,0x48,0x54,0x54,0x50,0x20,0x33,0x78,0x78,
-0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x73,0x3a,0x3c,
-0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
-0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,0x6e,0x64,0x65,
-0x78,0x20,0x24,0x63,0x6e,0x74,0x2e,0x43,0x6f,0x75,0x6e,0x74,
-0x65,0x72,0x73,0x42,0x79,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,
-0x65,0x43,0x6f,0x64,0x65,0x20,0x33,0x20,0x7d,0x7d,0x3c,0x2f,
-0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,
-0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
-0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x09,0x09,
-0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,0x48,0x54,0x54,0x50,0x20,
-0x34,0x78,0x78,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,
-0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,0x09,0x09,
-0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,0x20,0x69,
-0x6e,0x64,0x65,0x78,0x20,0x24,0x63,0x6e,0x74,0x2e,0x43,0x6f,
-0x75,0x6e,0x74,0x65,0x72,0x73,0x42,0x79,0x52,0x65,0x73,0x70,
-0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x34,0x20,0x7d,
-0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,
-0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,
-0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,0x09,0x09,0x09,
-0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,0x20,0x48,0x54,
-0x54,0x50,0x20,0x35,0x78,0x78,0x20,0x72,0x65,0x73,0x70,0x6f,
-0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,
-0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,
-0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,0x24,0x63,0x6e,0x74,
-0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x42,0x79,0x52,
-0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,
-0x35,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
-0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,
-0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x72,0x3e,0x0a,0x09,
-0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x68,0x3e,0x2d,
-0x20,0x6f,0x74,0x68,0x65,0x72,0x20,0x72,0x65,0x73,0x70,0x6f,
-0x6e,0x73,0x65,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,
-0x09,0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,
-0x7b,0x20,0x69,0x6e,0x64,0x65,0x78,0x20,0x24,0x63,0x6e,0x74,
-0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x73,0x42,0x79,0x52,
-0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,
-0x30,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
-0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,
-0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x61,0x62,0x6c,0x65,
-0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x64,0x69,0x76,
-0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x2f,0x75,0x3e,0x0a,0x09,
-0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
-0x74,0x64,0x3e,0x7b,0x7b,0x20,0x2e,0x52,0x61,0x74,0x65,0x4c,
-0x69,0x6d,0x69,0x74,0x65,0x72,0x2e,0x4c,0x61,0x73,0x74,0x50,
-0x61,0x63,0x6b,0x65,0x74,0x20,0x7c,0x20,0x61,0x67,0x65,0x20,
-0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,
-0x74,0x64,0x3e,0x31,0x37,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
-0x09,0x09,0x3c,0x74,0x64,0x3e,0x37,0x3c,0x2f,0x74,0x64,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,0x74,0x64,
-0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x32,0x32,0x32,
-0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,
-0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x75,0x3e,0x0a,0x09,0x09,
-0x09,0x09,0x09,0x33,0x32,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,
-0x64,0x69,0x76,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x74,0x69,
-0x70,0x73,0x3e,0x43,0x6f,0x6e,0x6e,0x65,0x63,0x74,0x69,0x6f,
-0x6e,0x20,0x72,0x65,0x73,0x65,0x74,0x73,0x20,0x64,0x75,0x72,
-0x69,0x6e,0x67,0x20,0x74,0x72,0x61,0x6e,0x73,0x66,0x65,0x72,
-0x73,0x3a,0x20,0x31,0x36,0x35,0x31,0x20,0x63,0x6c,0x69,0x65,
-0x6e,0x74,0x2c,0x20,0x30,0x20,0x73,0x65,0x72,0x76,0x65,0x72,
-0x3c,0x2f,0x64,0x69,0x76,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,
-0x2f,0x75,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x30,0x3c,0x2f,0x74,
-0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x30,0x3c,
-0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,
-0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,0x63,0x3e,0x36,0x64,0x35,
-0x68,0x20,0x55,0x50,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,
-0x09,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,0x3d,0x61,
-0x63,0x3e,0x26,0x6e,0x62,0x73,0x70,0x3b,0x3c,0x2f,0x74,0x64,
-0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,
-0x73,0x73,0x3d,0x61,0x63,0x3e,0x33,0x30,0x3c,0x2f,0x74,0x64,
-0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,
-0x73,0x73,0x3d,0x61,0x63,0x3e,0x33,0x3c,0x2f,0x74,0x64,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,
-0x73,0x3d,0x61,0x63,0x3e,0x30,0x3c,0x2f,0x74,0x64,0x3e,0x0a,
-0x09,0x09,0x09,0x3c,0x74,0x64,0x20,0x63,0x6c,0x61,0x73,0x73,
-0x3d,0x61,0x63,0x3e,0x26,0x6e,0x62,0x73,0x70,0x3b,0x3c,0x2f,
-0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x31,
-0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,
-0x3e,0x31,0x31,0x6d,0x34,0x38,0x73,0x3c,0x2f,0x74,0x64,0x3e,
-0x0a,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x3c,0x2f,0x74,0x64,
-0x3e,0x0a,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,0x09,0x3c,
-0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x09,0x3c,0x62,0x72,
-0x3e,0x7b,0x7b,0x65,0x6e,0x64,0x7d,0x7d,0x3c,0x2f,0x62,0x6f,
-0x64,0x79,0x3e,0x0a,0x3c,0x2f,0x68,0x74,0x6d,0x6c,0x3e,},
-	"stats.html", 420, time.Unix(1438475955, 0),
+0x68,0x3e,0x49,0x64,0x6c,0x65,0x20,0x74,0x69,0x6d,0x65,0x6f,
+0x75,0x74,0x73,0x3a,0x3c,0x2f,0x74,0x68,0x3e,0x0a,0x09,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x74,0x64,0x3e,0x7b,0x7b,
+0x20,0x2e,0x49,0x64,0x6c,0x65,0x54,0x69,0x6d,0x65,0x6f,0x75,
+0x74,0x73,0x20,0x7d,0x7d,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x72,0x3e,0x0a,
+0x09,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x74,0x61,0x62,0x6c,
+0x65,0x3e,0x0a,0x09,0x09,0x09,0x09,0x09,0x3c,0x2f,0x64,0x69,
+0x76,0x3e,0x0a,0x09,0x09,0x09,0x09,0x3c,0x2f,0x75,0x3e,0x0a,
+0x09,0x09,0x09,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x09,
+0x3c,0x74,0x64,0x20,0x63,0x6f,0x6c,0x73,0x70,0x61,0x6e,0x3d,
+0x31,0x37,0x3e,0x3c,0x2f,0x74,0x64,0x3e,0x0a,0x09,0x09,0x3c,
+0x2f,0x74,0x72,0x3e,0x0a,0x09,0x09,0x7b,0x7b,0x20,0x65,0x6e,
+0x64,0x20,0x7d,0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,
+0x7d,0x7b,0x7b,0x20,0x65,0x6e,0x64,0x20,0x7d,0x7d,0x0a,0x09,
+0x3c,0x2f,0x74,0x61,0x62,0x6c,0x65,0x3e,0x0a,0x09,0x3c,0x62,
+0x72,0x3e,0x7b,0x7b,0x65,0x6e,0x64,0x7d,0x7d,0x3c,0x2f,0x62,
+0x6f,0x64,0x79,0x3e,0x0a,0x3c,0x2f,0x68,0x74,0x6d,0x6c,0x3e,},
+	"stats.html", 420, time.Unix(1792371133, 0),
 }
\ No newline at end of file
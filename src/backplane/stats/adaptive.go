@@ -0,0 +1,150 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Adaptive limit algorithms selectable in config
+const (
+	AlgorithmGradient = "gradient"
+	AlgorithmAIMD     = "aimd"
+)
+
+// LatencyObserver is implemented by limiters adjusting to the observed latency.
+// Observe is called for every completed request with the number of requests in flight
+// when it started. dropped is true for requests that failed because of overload.
+type LatencyObserver interface {
+	Observe(rtt time.Duration, inflight int, dropped bool)
+}
+
+// AdaptiveLimiterConfig configures NewAdaptiveLimiter, zero values are replaced by defaults
+type AdaptiveLimiterConfig struct {
+	Algorithm    string  // gradient (default) or aimd
+	MinLimit     int     // default 1
+	MaxLimit     int     // default 1000
+	InitialLimit int     // default 20
+	BackoffRatio float64 // limit multiplier on drops, default 0.9
+	MaxQueue     int
+	QueueTimeout time.Duration
+}
+
+// adaptiveLimiter is a queueLimiter with the limit adjusted to the observed latency
+// in the style of Netflix concurrency-limits:
+//
+// gradient compares long term RTT with the recent RTT. While they are equal the limit grows
+// by a queue allowance of sqrt(limit), as the recent RTT grows (requests start queueing
+// on the server) the limit shrinks proportionally.
+//
+// aimd grows the limit by 1 for every successful request.
+//
+// Both multiply the limit by the backoff ratio on drops. The limit only grows when it is
+// actually used, at least half of it is in flight.
+type adaptiveLimiter struct {
+	*queueLimiter
+	cf AdaptiveLimiterConfig //final
+
+	mu       sync.Mutex
+	limit    float64
+	shortRtt float64 // EMA of RTT over ~10 samples, ns
+	longRtt  float64 // EMA of RTT over ~600 samples, ns
+	measured bool
+}
+
+const (
+	shortRttWeight    = 0.2
+	longRttWeight     = 1. / 600
+	gradientSmoothing = 0.2
+)
+
+// NewAdaptiveLimiter creates a limiter adjusting its limit within configured bounds.
+// It has to be fed with Observe.
+func NewAdaptiveLimiter(cf AdaptiveLimiterConfig) (Limiter, error) {
+	switch cf.Algorithm {
+	case "":
+		cf.Algorithm = AlgorithmGradient
+	case AlgorithmGradient, AlgorithmAIMD:
+	default:
+		return nil, fmt.Errorf("unknown adaptive limit algorithm %s", cf.Algorithm)
+	}
+	if cf.MinLimit <= 0 {
+		cf.MinLimit = 1
+	}
+	if cf.MaxLimit <= 0 {
+		cf.MaxLimit = 1000
+	}
+	if cf.MaxLimit < cf.MinLimit {
+		return nil, fmt.Errorf("adaptive limit: max_limit %d is below min_limit %d", cf.MaxLimit, cf.MinLimit)
+	}
+	if cf.InitialLimit <= 0 {
+		cf.InitialLimit = 20
+	}
+	if cf.BackoffRatio <= 0 || cf.BackoffRatio >= 1 {
+		cf.BackoffRatio = 0.9
+	}
+	a := &adaptiveLimiter{
+		queueLimiter: &queueLimiter{maxQueue: cf.MaxQueue, timeout: cf.QueueTimeout},
+		cf:           cf,
+	}
+	a.limit = a.clamp(float64(cf.InitialLimit))
+	a.queueLimiter.limit = int(a.limit)
+	return a, nil
+}
+
+func (a *adaptiveLimiter) clamp(limit float64) float64 {
+	return math.Max(float64(a.cf.MinLimit), math.Min(float64(a.cf.MaxLimit), limit))
+}
+
+func (a *adaptiveLimiter) Observe(rtt time.Duration, inflight int, dropped bool) {
+	a.mu.Lock()
+	old := int(a.limit)
+	switch a.cf.Algorithm {
+	case AlgorithmAIMD:
+		a.aimd(inflight, dropped)
+	default:
+		a.gradient(float64(rtt), inflight, dropped)
+	}
+	a.limit = a.clamp(a.limit)
+	limit := int(a.limit)
+	a.mu.Unlock()
+	if limit != old {
+		a.setLimit(limit)
+	}
+}
+
+func (a *adaptiveLimiter) aimd(inflight int, dropped bool) {
+	if dropped {
+		a.limit = math.Floor(a.limit * a.cf.BackoffRatio)
+	} else if float64(inflight)*2 >= a.limit {
+		a.limit++
+	}
+}
+
+func (a *adaptiveLimiter) gradient(rtt float64, inflight int, dropped bool) {
+	if dropped {
+		// failed requests say nothing about the latency, but tell the server is overloaded
+		a.limit = math.Floor(a.limit * a.cf.BackoffRatio)
+		return
+	}
+	if !a.measured {
+		a.shortRtt, a.longRtt, a.measured = rtt, rtt, true
+	}
+	a.shortRtt = (1-shortRttWeight)*a.shortRtt + shortRttWeight*rtt
+	a.longRtt = (1-longRttWeight)*a.longRtt + longRttWeight*rtt
+	// recover faster after the latency went down for good
+	if a.longRtt/a.shortRtt > 2 {
+		a.longRtt *= 0.95
+	}
+	if float64(inflight)*2 < a.limit {
+		return
+	}
+	gradient := math.Max(0.5, math.Min(1, a.longRtt/a.shortRtt))
+	newLimit := a.limit*gradient + math.Sqrt(a.limit)
+	a.limit = a.limit*(1-gradientSmoothing) + newLimit*gradientSmoothing
+}
+
+func (a *adaptiveLimiter) Adaptive() string {
+	return fmt.Sprintf("%s %d..%d", a.cf.Algorithm, a.cf.MinLimit, a.cf.MaxLimit)
+}
@@ -0,0 +1,137 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestAIMD(t *testing.T) {
+	l, err := NewAdaptiveLimiter(AdaptiveLimiterConfig{Algorithm: AlgorithmAIMD, MinLimit: 5, MaxLimit: 30, InitialLimit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := l.(LatencyObserver)
+	// not using the limit does not grow it
+	o.Observe(time.Millisecond, 1, false)
+	if l.Limit() != 10 {
+		t.Errorf("expected limit 10 got %d", l.Limit())
+	}
+	for i := 0; i < 100; i++ {
+		o.Observe(time.Millisecond, l.Limit(), false)
+	}
+	if l.Limit() != 30 {
+		t.Errorf("expected limit to reach max 30, got %d", l.Limit())
+	}
+	o.Observe(time.Millisecond, 30, true)
+	if l.Limit() != 27 {
+		t.Errorf("expected backoff to 27 got %d", l.Limit())
+	}
+	for i := 0; i < 100; i++ {
+		o.Observe(time.Millisecond, 30, true)
+	}
+	if l.Limit() != 5 || l.Adaptive() != "aimd 5..30" {
+		t.Errorf("expected limit to stay at min 5, got %d %s", l.Limit(), l.Adaptive())
+	}
+}
+
+func TestGradient(t *testing.T) {
+	l, err := NewAdaptiveLimiter(AdaptiveLimiterConfig{MinLimit: 2, MaxLimit: 100, InitialLimit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := l.(LatencyObserver)
+	for i := 0; i < 200; i++ {
+		o.Observe(10*time.Millisecond, l.Limit(), false)
+	}
+	if l.Limit() != 100 {
+		t.Errorf("expected limit to grow to 100 with steady latency, got %d", l.Limit())
+	}
+	// servers start queueing
+	for i := 0; i < 50; i++ {
+		o.Observe(40*time.Millisecond, l.Limit(), false)
+	}
+	if l.Limit() > 30 {
+		t.Errorf("expected limit to shrink when latency grows, got %d", l.Limit())
+	}
+	if _, err := NewAdaptiveLimiter(AdaptiveLimiterConfig{Algorithm: "vegas"}); err == nil {
+		t.Error("expected error for unknown algorithm")
+	}
+	if _, err := NewAdaptiveLimiter(AdaptiveLimiterConfig{MinLimit: 10, MaxLimit: 5}); err == nil {
+		t.Error("expected error for max below min")
+	}
+}
+
+func TestLimitGrowthLetsWaitersIn(t *testing.T) {
+	l, _ := NewAdaptiveLimiter(AdaptiveLimiterConfig{Algorithm: AlgorithmAIMD, InitialLimit: 1})
+	l.Acquire(nil)
+	done := make(chan error)
+	go func() { done <- l.AcquireQueued(context.Background(), nil, 0) }()
+	waitQueued(t, l, 1)
+	l.(LatencyObserver).Observe(time.Millisecond, 1, false)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("waiter was not let in after the limit grew")
+	}
+	if l.Size() != 2 {
+		t.Errorf("expected 2 in flight got %d", l.Size())
+	}
+}
+
+type fakeRoundTripper struct {
+	status int
+	err    error
+}
+
+func (f fakeRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &http.Response{StatusCode: f.status, Body: ioutil.NopCloser(strings.NewReader("ok"))}, nil
+}
+
+func TestRoundTripperFeedsAdaptiveLimiter(t *testing.T) {
+	l, _ := NewAdaptiveLimiter(AdaptiveLimiterConfig{Algorithm: AlgorithmAIMD, InitialLimit: 10})
+	rt := &CountersCollectingRoundTripper{RoundTripper: fakeRoundTripper{status: 200}, Limiter: l, TraceFamily: "test"}
+	req, _ := http.NewRequest("GET", "http://server/", nil)
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Size() != 1 {
+		t.Errorf("expected allocation to be held until the body is closed, size %d", l.Size())
+	}
+	resp.Body.Close()
+	resp.Body.Close()
+	if l.Size() != 0 {
+		t.Errorf("expected allocation to be released, size %d", l.Size())
+	}
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+	for _, f := range []fakeRoundTripper{{status: 503}, {err: refused}} {
+		rt.RoundTripper = f
+		if resp, err := rt.RoundTrip(req); err == nil {
+			resp.Body.Close()
+		}
+	}
+	if l.Limit() != 8 || l.Size() != 0 {
+		t.Errorf("expected two backoffs to 8, got limit %d size %d", l.Limit(), l.Size())
+	}
+	// neither client cancellations nor other failures are overload
+	for _, f := range []fakeRoundTripper{{err: context.Canceled}, {err: errors.New("malformed HTTP response")}, {status: 500}} {
+		rt.RoundTripper = f
+		if resp, err := rt.RoundTrip(req); err == nil {
+			resp.Body.Close()
+		}
+	}
+	if l.Limit() != 8 {
+		t.Errorf("expected no backoff, got limit %d", l.Limit())
+	}
+}
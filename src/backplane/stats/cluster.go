@@ -0,0 +1,157 @@
+package stats
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// RateExchange shares demand of cluster-wide rate limits between cluster members.
+// ExchangeRates publishes local demand (requests per second offered to every limiter, keyed by
+// limiter name) and returns the latest demand reported by other live members, keyed by member name.
+type RateExchange interface {
+	ExchangeRates(local map[string]float64) map[string]map[string]float64
+}
+
+// ClusterRates keeps rate limiters enforcing a cluster-wide rate. Every member running
+// a limiter with the same name gets a local budget of global rate x local share where the share is:
+//
+// - while the cluster is under the limit, the member's own demand plus an equal part of the headroom
+//
+// - while the cluster is over the limit, the member's part of the total demand
+//
+// Members not reporting the limiter (down, left, or not configured with it) do not get a share,
+// so budgets are rebalanced as members join and fail. A single member gets the whole global rate.
+type ClusterRates struct {
+	mu       sync.Mutex
+	limiters map[string]*clusterRateLimiter
+	last     time.Time
+	marked   map[string]bool // limiters registered since Mark
+}
+
+// minimal budget of a member as a part of the equal share, so idle members can ramp up
+const minClusterShare = 0.01
+
+func NewClusterRates() *ClusterRates {
+	return &ClusterRates{limiters: make(map[string]*clusterRateLimiter), last: time.Now()}
+}
+
+// RateLimiter returns token bucket rate limiter enforcing local budget of the named cluster-wide rate.
+// It is registered with the same name on every member. A limiter registered again
+// with the same name replaces the previous one.
+func (c *ClusterRates) RateLimiter(name string, globalQPS float64, burst int) RateLimiter {
+	if burst <= 0 {
+		burst = int(math.Ceil(globalQPS))
+	}
+	l := &clusterRateLimiter{
+		tokenBucketRateLimiter: NewTokenBucketRateLimiter(globalQPS, burst).(*tokenBucketRateLimiter),
+		global:                 globalQPS,
+		globalBurst:            float64(burst),
+	}
+	c.mu.Lock()
+	c.limiters[name] = l
+	if c.marked != nil {
+		c.marked[name] = true
+	}
+	c.mu.Unlock()
+	return l
+}
+
+// Unregister removes the limiter registered under name, unless it was replaced by another one since
+func (c *ClusterRates) Unregister(name string, l RateLimiter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cur, ok := c.limiters[name]; ok && cur == l {
+		delete(c.limiters, name)
+	}
+}
+
+// Mark starts a new configuration, limiters not registered again until Sweep are removed
+func (c *ClusterRates) Mark() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.marked = make(map[string]bool)
+}
+
+// Sweep removes limiters not registered since Mark. Removed limiters keep their last budget,
+// they are no longer rebalanced and their demand is not reported to other members.
+func (c *ClusterRates) Sweep() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.marked == nil {
+		return
+	}
+	for name := range c.limiters {
+		if !c.marked[name] {
+			glog.V(1).Infof("cluster rate %s is no longer configured", name)
+			delete(c.limiters, name)
+		}
+	}
+	c.marked = nil
+}
+
+// Rebalance exchanges demand measured since the last call and updates local budgets
+func (c *ClusterRates) Rebalance(ex RateExchange) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	elapsed := now.Sub(c.last).Seconds()
+	c.last = now
+	local := make(map[string]float64, len(c.limiters))
+	for name, l := range c.limiters {
+		local[name] = l.measure(elapsed)
+	}
+	peers := ex.ExchangeRates(local)
+	for name, l := range c.limiters {
+		members, total := 1., local[name]
+		for _, rates := range peers {
+			if rate, ok := rates[name]; ok {
+				members++
+				total += rate
+			}
+		}
+		var budget float64
+		if total <= l.global {
+			budget = local[name] + (l.global-total)/members
+		} else {
+			budget = l.global * local[name] / total
+		}
+		budget = math.Max(budget, minClusterShare*l.global/members)
+		glog.V(2).Infof("cluster rate %s: demand %.1f of %.1f by %.0f members, local budget %.1f", name, local[name], total, members, budget)
+		l.setRate(budget, l.globalBurst*budget/l.global)
+	}
+}
+
+// Run rebalances budgets every period until stop is closed
+func (c *ClusterRates) Run(ex RateExchange, period time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			c.Rebalance(ex)
+		}
+	}
+}
+
+// clusterRateLimiter is a token bucket refilled at the local budget of the cluster-wide rate
+type clusterRateLimiter struct {
+	*tokenBucketRateLimiter
+	global, globalBurst float64 //final
+	lastCount           int64
+}
+
+// measure returns rate of requests offered to the limiter since the last call
+func (l *clusterRateLimiter) measure(elapsed float64) float64 {
+	count := l.TotalAcceptedCount() + l.TotalRejectedCount()
+	offered := count - l.lastCount
+	l.lastCount = count
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(offered) / elapsed
+}
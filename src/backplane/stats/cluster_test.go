@@ -0,0 +1,110 @@
+package stats
+
+import (
+	"testing"
+	"time"
+)
+
+type fakeExchange struct {
+	published map[string]float64
+	peers     map[string]map[string]float64
+}
+
+func (f *fakeExchange) ExchangeRates(local map[string]float64) map[string]map[string]float64 {
+	f.published = local
+	return f.peers
+}
+
+func TestClusterRates(t *testing.T) {
+	c := NewClusterRates()
+	rl := c.RateLimiter("backend b", 100, 0)
+	ex := &fakeExchange{}
+	// alone in the cluster
+	c.Rebalance(ex)
+	if rl.TargetQPS() != 100 {
+		t.Errorf("expected single member to get whole rate, got %d", rl.TargetQPS())
+	}
+	// 3 idle members share the headroom equally
+	ex.peers = map[string]map[string]float64{
+		"10.0.0.2:7946": {"backend b": 0},
+		"10.0.0.3:7946": {"backend b": 0, "backend other": 50},
+		"10.0.0.4:7946": {"backend other": 50},
+	}
+	c.Rebalance(ex)
+	if rl.TargetQPS() != 33 {
+		t.Errorf("expected a third of the rate, got %d", rl.TargetQPS())
+	}
+	// over the limit, shares are proportional to demand
+	ex.peers["10.0.0.2:7946"]["backend b"] = 300
+	ex.peers["10.0.0.3:7946"]["backend b"] = 0
+	c.last = time.Now().Add(-time.Second)
+	acceptedOf(rl, 100)
+	c.Rebalance(ex)
+	if d := ex.published["backend b"]; d < 90 || d > 100 {
+		t.Errorf("expected demand of ~100 qps to be published, got %f", d)
+	}
+	if q := rl.TargetQPS(); q < 22 || q > 25 {
+		t.Errorf("expected a quarter of the rate, got %d", q)
+	}
+	// the peers fail
+	ex.peers = nil
+	c.Rebalance(ex)
+	if rl.TargetQPS() != 100 {
+		t.Errorf("expected whole rate after peers left, got %d", rl.TargetQPS())
+	}
+}
+
+func TestClusterRatesSweep(t *testing.T) {
+	c := NewClusterRates()
+	c.RateLimiter("backend a", 100, 0)
+	c.RateLimiter("backend b", 100, 0)
+	c.Mark()
+	c.RateLimiter("backend b", 200, 0)
+	c.Sweep()
+	ex := &fakeExchange{}
+	c.Rebalance(ex)
+	if _, ok := ex.published["backend a"]; ok || len(ex.published) != 1 {
+		t.Errorf("expected only configured limiters to be published, got %v", ex.published)
+	}
+}
+
+func TestClusterRatesUnregister(t *testing.T) {
+	c := NewClusterRates()
+	old := c.RateLimiter("backend a", 100, 0)
+	c.RateLimiter("backend a", 200, 0)
+	b := c.RateLimiter("backend b", 100, 0)
+	c.Unregister("backend a", old)
+	c.Unregister("backend b", b)
+	ex := &fakeExchange{}
+	c.Rebalance(ex)
+	if _, ok := ex.published["backend a"]; !ok || len(ex.published) != 1 {
+		t.Errorf("expected only the replaced limiter to stay, got %v", ex.published)
+	}
+}
+
+type countingExchange struct {
+	calls chan struct{}
+}
+
+func (e *countingExchange) ExchangeRates(local map[string]float64) map[string]map[string]float64 {
+	e.calls <- struct{}{}
+	return nil
+}
+
+func TestClusterRatesRunStops(t *testing.T) {
+	c := NewClusterRates()
+	ex := &countingExchange{calls: make(chan struct{}, 100)}
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		c.Run(ex, time.Millisecond, stop)
+		close(done)
+	}()
+	<-ex.calls
+	close(stop)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not stop")
+	}
+}
@@ -0,0 +1,134 @@
+package stats
+
+import (
+	"container/list"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const DefaultMaxKeys = 10000
+
+// KeyedRateLimiter limits request rate separately for every key, like a client ip or user.
+// Every key gets a token bucket refilled at rate tokens per second and holding up to burst tokens.
+// Buckets are kept in a bounded LRU, a forgotten key starts over with a full bucket.
+type KeyedRateLimiter struct {
+	limits    bucketLimits
+	overrides map[string]bucketLimits
+	maxKeys   int
+
+	mu      sync.Mutex
+	lru     *list.List // of *keyBucket, most recently used first
+	buckets map[string]*list.Element
+
+	requestAcceptedCount, requestThrottledCount int64
+}
+
+type bucketLimits struct {
+	rate, burst float64
+}
+
+type keyBucket struct {
+	key    string
+	limits bucketLimits
+	tokens float64
+	last   time.Time
+}
+
+// NewKeyedRateLimiter creates a limiter allowing rate requests per second for every key.
+// burst defaults to rate rounded up, maxKeys to DefaultMaxKeys.
+func NewKeyedRateLimiter(rate float64, burst int, maxKeys int) *KeyedRateLimiter {
+	if maxKeys <= 0 {
+		maxKeys = DefaultMaxKeys
+	}
+	return &KeyedRateLimiter{
+		limits:    newBucketLimits(rate, burst),
+		overrides: make(map[string]bucketLimits),
+		maxKeys:   maxKeys,
+		lru:       list.New(),
+		buckets:   make(map[string]*list.Element),
+	}
+}
+
+func newBucketLimits(rate float64, burst int) bucketLimits {
+	if burst <= 0 {
+		burst = int(math.Ceil(rate))
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return bucketLimits{rate: rate, burst: float64(burst)}
+}
+
+// SetOverride replaces limits for a single key. rate 0 makes the key unlimited.
+// Should be called before the limiter is used.
+func (k *KeyedRateLimiter) SetOverride(key string, rate float64, burst int) {
+	k.overrides[key] = newBucketLimits(rate, burst)
+}
+
+// Accept takes a token from the key bucket. If the bucket is empty, the request is rejected
+// and the time until the next token is returned.
+func (k *KeyedRateLimiter) Accept(key string) (bool, time.Duration) {
+	now := time.Now()
+	k.mu.Lock()
+	b := k.bucket(key, now)
+	if b.limits.rate > 0 {
+		b.tokens = math.Min(b.limits.burst, b.tokens+now.Sub(b.last).Seconds()*b.limits.rate)
+	}
+	b.last = now
+	if b.limits.rate > 0 && b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) / b.limits.rate * nanosInSeconds)
+		k.mu.Unlock()
+		atomic.AddInt64(&k.requestThrottledCount, 1)
+		return false, wait
+	}
+	b.tokens--
+	k.mu.Unlock()
+	atomic.AddInt64(&k.requestAcceptedCount, 1)
+	return true, 0
+}
+
+// bucket returns the key bucket marking it as most recently used. Must be called with mu held.
+func (k *KeyedRateLimiter) bucket(key string, now time.Time) *keyBucket {
+	if e, ok := k.buckets[key]; ok {
+		k.lru.MoveToFront(e)
+		return e.Value.(*keyBucket)
+	}
+	if k.lru.Len() >= k.maxKeys {
+		oldest := k.lru.Back()
+		k.lru.Remove(oldest)
+		delete(k.buckets, oldest.Value.(*keyBucket).key)
+	}
+	limits, ok := k.overrides[key]
+	if !ok {
+		limits = k.limits
+	}
+	b := &keyBucket{key: key, limits: limits, tokens: limits.burst, last: now}
+	k.buckets[key] = k.lru.PushFront(b)
+	return b
+}
+
+// Keys returns the number of keys currently tracked
+func (k *KeyedRateLimiter) Keys() int {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.lru.Len()
+}
+
+// TargetQPS returns configured rate per key
+func (k *KeyedRateLimiter) TargetQPS() float64 {
+	return k.limits.rate
+}
+
+// TotalAcceptedCount returs total number of accepted queries over the
+// limiter lifetime
+func (k *KeyedRateLimiter) TotalAcceptedCount() int64 {
+	return atomic.LoadInt64(&k.requestAcceptedCount)
+}
+
+// TotalRejectedCount returs total number of rejected queries over the
+// limiter lifetime
+func (k *KeyedRateLimiter) TotalRejectedCount() int64 {
+	return atomic.LoadInt64(&k.requestThrottledCount)
+}
@@ -2,26 +2,62 @@
 
 package stats
 
-import "golang.org/x/net/trace"
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"golang.org/x/net/trace"
+)
+
+var (
+	QueueFull    = errors.New("Limiter queue is full")
+	QueueTimeout = errors.New("Timed out in limiter queue")
+)
 
 type Limiter interface {
 	// Acquire allocation from the limiter. this call must be accompanied by Release(tr)
+	// Waits as long as needed regardless of the queue limits.
 	Acquire(tr trace.Trace)
+	// AcquireQueued waits for allocation in the queue, waiters with higher priority are served first.
+	// QueueFull, QueueTimeout or the error of ctx done while waiting is returned if allocation
+	// was not acquired, Release must not be called then.
+	AcquireQueued(ctx context.Context, tr trace.Trace, priority int) error
 	// Release allocation
 	Release(tr trace.Trace)
 	// Get current number of allocations acquired.
 	Size() int
 	// Get configured limit. 0 is unlimited
 	Limit() int
+	// Get current number of waiters
+	Queued() int
+	// Get configured queue length. 0 is unlimited
+	QueueLimit() int
+	// Get configured max time in the queue. 0 is unlimited
+	QueueTimeout() time.Duration
+	// Average and max time spent in the queue by acquired allocations
+	AvgWait() time.Duration
+	MaxWait() time.Duration
+	// Number of waiters rejected because the queue was full or timed out
+	Dropped() int64
+	// Adjusting algorithm and bounds of adaptive limiters, empty for static ones
+	Adaptive() string
 }
 
-// Create a new limiter with n allowed items
+// Create a new limiter with n allowed items and unlimited queue
 func NewLimiter(n int) Limiter {
+	return NewQueueLimiter(n, 0, 0)
+}
+
+// Create a new limiter with n allowed items, up to maxQueue waiters waiting at most timeout
+func NewQueueLimiter(n int, maxQueue int, timeout time.Duration) Limiter {
 	switch n {
 	case 0:
 		return unlimitedLimiter{}
 	default:
-		return &semaLimiter{make(chan struct{}, n)}
+		return &queueLimiter{limit: n, maxQueue: maxQueue, timeout: timeout}
 	}
 }
 
@@ -29,37 +65,228 @@ type unlimitedLimiter struct {
 }
 
 func (l unlimitedLimiter) Acquire(tr trace.Trace) {}
-func (l unlimitedLimiter) Release(tr trace.Trace) {}
-func (l unlimitedLimiter) Size() int              { return 0 }
-func (l unlimitedLimiter) Limit() int             { return 0 }
+func (l unlimitedLimiter) AcquireQueued(ctx context.Context, tr trace.Trace, priority int) error {
+	return nil
+}
+func (l unlimitedLimiter) Release(tr trace.Trace)      {}
+func (l unlimitedLimiter) Size() int                   { return 0 }
+func (l unlimitedLimiter) Limit() int                  { return 0 }
+func (l unlimitedLimiter) Queued() int                 { return 0 }
+func (l unlimitedLimiter) QueueLimit() int             { return 0 }
+func (l unlimitedLimiter) QueueTimeout() time.Duration { return 0 }
+func (l unlimitedLimiter) AvgWait() time.Duration      { return 0 }
+func (l unlimitedLimiter) MaxWait() time.Duration      { return 0 }
+func (l unlimitedLimiter) Dropped() int64              { return 0 }
+func (l unlimitedLimiter) Adaptive() string            { return "" }
 
-type semaLimiter struct {
-	sem chan struct{}
+type waiter struct {
+	priority int
+	seq      uint64
+	ready    chan struct{}
+	index    int // position in the queue, -1 once removed
 }
 
-func (l *semaLimiter) Acquire(tr trace.Trace) {
+// waitQueue is a heap of waiters, highest priority first, FIFO within the same priority
+type waitQueue []*waiter
+
+func (q waitQueue) Len() int { return len(q) }
+func (q waitQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+	return q[i].seq < q[j].seq
+}
+func (q waitQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+func (q *waitQueue) Push(x interface{}) {
+	w := x.(*waiter)
+	w.index = len(*q)
+	*q = append(*q, w)
+}
+func (q *waitQueue) Pop() interface{} {
+	old := *q
+	w := old[len(old)-1]
+	old[len(old)-1] = nil
+	w.index = -1
+	*q = old[:len(old)-1]
+	return w
+}
+
+type queueLimiter struct {
+	maxQueue int           //final
+	timeout  time.Duration //final
+
+	mu                         sync.Mutex
+	limit                      int
+	active                     int
+	queue                      waitQueue
+	seq                        uint64
+	waits, waitedNs, maxWaitNs int64
+	dropped                    int64
+}
+
+func (l *queueLimiter) Acquire(tr trace.Trace) {
+	l.wait(context.Background(), tr, 0, 0, 0)
+}
+
+func (l *queueLimiter) AcquireQueued(ctx context.Context, tr trace.Trace, priority int) error {
+	return l.wait(ctx, tr, priority, l.maxQueue, l.timeout)
+}
+
+func (l *queueLimiter) wait(ctx context.Context, tr trace.Trace, priority int, maxQueue int, timeout time.Duration) error {
+	l.mu.Lock()
 	if tr != nil {
-		tr.LazyPrintf("Acquiring semalimiter out of %d", l.Limit())
+		tr.LazyPrintf("Acquiring limiter out of %d", l.limit)
+	}
+	if l.active < l.limit {
+		l.active++
+		l.mu.Unlock()
+		if tr != nil {
+			tr.LazyPrintf("limiter acquired")
+		}
+		return nil
+	}
+	if maxQueue > 0 && len(l.queue) >= maxQueue {
+		l.dropped++
+		l.mu.Unlock()
+		if tr != nil {
+			tr.LazyPrintf("limiter queue is full")
+		}
+		return QueueFull
 	}
-	l.sem <- struct{}{}
+	w := &waiter{priority: priority, seq: l.seq, ready: make(chan struct{})}
+	l.seq++
+	heap.Push(&l.queue, w)
+	l.mu.Unlock()
+
+	start := time.Now()
+	var expired <-chan time.Time
+	if timeout > 0 {
+		t := time.NewTimer(timeout)
+		defer t.Stop()
+		expired = t.C
+	}
+	select {
+	case <-w.ready:
+	case <-expired:
+		l.mu.Lock()
+		if w.index >= 0 {
+			heap.Remove(&l.queue, w.index)
+			l.dropped++
+			l.mu.Unlock()
+			if tr != nil {
+				tr.LazyPrintf("timed out in limiter queue after %s", timeout)
+			}
+			return QueueTimeout
+		}
+		// Release handed the allocation over at the same moment
+		l.mu.Unlock()
+	case <-ctx.Done():
+		// the client is gone, its slot in the queue goes to others
+		l.mu.Lock()
+		handedOver := w.index < 0
+		if !handedOver {
+			heap.Remove(&l.queue, w.index)
+		}
+		l.mu.Unlock()
+		if handedOver {
+			l.Release(tr)
+		}
+		if tr != nil {
+			tr.LazyPrintf("left limiter queue: %s", ctx.Err())
+		}
+		return ctx.Err()
+	}
+	waited := int64(time.Since(start))
+	l.mu.Lock()
+	l.waits++
+	l.waitedNs += waited
+	if waited > l.maxWaitNs {
+		l.maxWaitNs = waited
+	}
+	l.mu.Unlock()
 	if tr != nil {
-		tr.LazyPrintf("semalimiter acquired")
+		tr.LazyPrintf("limiter acquired after %s in queue", time.Duration(waited))
 	}
+	return nil
 }
-func (l *semaLimiter) Release(tr trace.Trace) {
+
+func (l *queueLimiter) Release(tr trace.Trace) {
 	if tr != nil {
-		tr.LazyPrintf("releasing semalimiter")
+		tr.LazyPrintf("releasing limiter")
+	}
+	l.mu.Lock()
+	if len(l.queue) > 0 && l.active <= l.limit {
+		// hand the allocation over to the first waiter
+		w := heap.Pop(&l.queue).(*waiter)
+		close(w.ready)
+	} else {
+		l.active--
 	}
-	<-l.sem
+	l.mu.Unlock()
 	if tr != nil {
-		tr.LazyPrintf("semalimiter released")
+		tr.LazyPrintf("limiter released")
 	}
 }
 
 // Currently used
-func (l *semaLimiter) Size() int { return len(l.sem) }
+func (l *queueLimiter) Size() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.active
+}
 
 // max configured limit
-func (l *semaLimiter) Limit() int { return cap(l.sem) }
+func (l *queueLimiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limit
+}
+
+// setLimit changes the limit. Waiters are let in if it grows, allocations over
+// the limit are not handed over on release if it shrinks.
+func (l *queueLimiter) setLimit(n int) {
+	l.mu.Lock()
+	l.limit = n
+	for l.active < l.limit && len(l.queue) > 0 {
+		l.active++
+		w := heap.Pop(&l.queue).(*waiter)
+		close(w.ready)
+	}
+	l.mu.Unlock()
+}
+
+func (l *queueLimiter) Queued() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.queue)
+}
+
+func (l *queueLimiter) QueueLimit() int             { return l.maxQueue }
+func (l *queueLimiter) QueueTimeout() time.Duration { return l.timeout }
+
+func (l *queueLimiter) AvgWait() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.waits == 0 {
+		return 0
+	}
+	return time.Duration(l.waitedNs / l.waits)
+}
+
+func (l *queueLimiter) MaxWait() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return time.Duration(l.maxWaitNs)
+}
+
+func (l *queueLimiter) Dropped() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.dropped
+}
 
-//TODO: timed acquire
+func (l *queueLimiter) Adaptive() string { return "" }
@@ -0,0 +1,97 @@
+package stats
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func waitQueued(t *testing.T, l Limiter, n int) {
+	for i := 0; i < 200 && l.Queued() != n; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	if l.Queued() != n {
+		t.Fatalf("expected %d queued, got %d", n, l.Queued())
+	}
+}
+
+func TestQueueLimits(t *testing.T) {
+	l := NewQueueLimiter(1, 1, 20*time.Millisecond)
+	if err := l.AcquireQueued(context.Background(), nil, 0); err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() { done <- l.AcquireQueued(context.Background(), nil, 0) }()
+	waitQueued(t, l, 1)
+	if err := l.AcquireQueued(context.Background(), nil, 0); err != QueueFull {
+		t.Errorf("expected QueueFull got %v", err)
+	}
+	if err := <-done; err != QueueTimeout {
+		t.Errorf("expected QueueTimeout got %v", err)
+	}
+	if l.Dropped() != 2 || l.Queued() != 0 || l.Size() != 1 {
+		t.Errorf("unexpected state: dropped %d queued %d size %d", l.Dropped(), l.Queued(), l.Size())
+	}
+	l.Release(nil)
+	if l.Size() != 0 {
+		t.Errorf("expected limiter to be free, size %d", l.Size())
+	}
+}
+
+func TestQueueCancel(t *testing.T) {
+	l := NewQueueLimiter(1, 1, 0)
+	l.Acquire(nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- l.AcquireQueued(ctx, nil, 0) }()
+	waitQueued(t, l, 1)
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("expected context.Canceled got %v", err)
+	}
+	// the slot is free for others and the allocation is not taken by the gone client
+	go func() { done <- l.AcquireQueued(context.Background(), nil, 0) }()
+	waitQueued(t, l, 1)
+	l.Release(nil)
+	if err := <-done; err != nil {
+		t.Error(err)
+	}
+	l.Release(nil)
+	if l.Size() != 0 || l.Queued() != 0 || l.Dropped() != 0 {
+		t.Errorf("unexpected state: dropped %d queued %d size %d", l.Dropped(), l.Queued(), l.Size())
+	}
+}
+
+func TestQueuePriority(t *testing.T) {
+	l := NewQueueLimiter(1, 0, 0)
+	l.Acquire(nil)
+	var mu sync.Mutex
+	var order []int
+	var wg sync.WaitGroup
+	for i, priority := range []int{0, 0, 10, 5} {
+		wg.Add(1)
+		go func(priority int) {
+			defer wg.Done()
+			if err := l.AcquireQueued(context.Background(), nil, priority); err != nil {
+				t.Error(err)
+				return
+			}
+			mu.Lock()
+			order = append(order, priority)
+			mu.Unlock()
+			l.Release(nil)
+		}(priority)
+		// make arrival order deterministic
+		waitQueued(t, l, i+1)
+	}
+	time.Sleep(5 * time.Millisecond)
+	l.Release(nil)
+	wg.Wait()
+	if len(order) != 4 || order[0] != 10 || order[1] != 5 || order[2] != 0 || order[3] != 0 {
+		t.Errorf("unexpected service order %v", order)
+	}
+	if l.MaxWait() < 5*time.Millisecond || l.AvgWait() == 0 || l.Dropped() != 0 {
+		t.Errorf("unexpected wait stats avg %s max %s", l.AvgWait(), l.MaxWait())
+	}
+}
@@ -12,6 +12,9 @@ type RateLimiter interface {
 
 	TargetQPS() int64
 
+	// Unlimited is true for limiters accepting everything
+	Unlimited() bool
+
 	// MaxQPS returns max achieved EMA(QPS) since the rate limiter created
 	MaxQPS() int64
 
@@ -29,10 +32,11 @@ type RateLimiter interface {
 	LastPacket() time.Time
 }
 
-//NewRateLimiter constructs a new EMA rate limiter with specified EMA cutoff
+//NewRateLimiter constructs a new EMA rate limiter with specified EMA cutoff.
+//maxQPS <= 0 means unlimited
 func NewRateLimiter(maxQPS float64) RateLimiter {
-	if maxQPS < 0.1 {
-		maxQPS = 1000000
+	if maxQPS <= 0 {
+		return NewUnlimitedRateLimiter()
 	}
 	return &emaRateLimiter{
 		timeOfLastRequest: time.Now().UnixNano(),
@@ -107,6 +111,10 @@ func (e *emaRateLimiter) TargetQPS() int64 {
 	return iNanosInSeconds / e.targetWaitingNs
 }
 
+func (e *emaRateLimiter) Unlimited() bool {
+	return false
+}
+
 // MaxQPS returns max achieved EMA(QPS) since the rate limiter created
 func (e *emaRateLimiter) MaxQPS() int64 {
 	return iNanosInSeconds / e.minWaitingNs
@@ -0,0 +1,228 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Rate limiting algorithms selectable in config
+const (
+	AlgorithmEMA           = "ema"
+	AlgorithmTokenBucket   = "token_bucket"
+	AlgorithmSlidingWindow = "sliding_window"
+)
+
+// NewRateLimiterAlgorithm constructs a rate limiter using the named algorithm, EMA if empty.
+// burst is the number of requests allowed at once, it defaults to maxQPS rounded up
+// and is ignored by EMA. maxQPS <= 0 means unlimited.
+func NewRateLimiterAlgorithm(algorithm string, maxQPS float64, burst int) (RateLimiter, error) {
+	if maxQPS <= 0 {
+		switch algorithm {
+		case "", AlgorithmEMA, AlgorithmTokenBucket, AlgorithmSlidingWindow:
+			return NewUnlimitedRateLimiter(), nil
+		}
+	}
+	if burst <= 0 {
+		burst = int(math.Ceil(maxQPS))
+	}
+	switch algorithm {
+	case "", AlgorithmEMA:
+		return NewRateLimiter(maxQPS), nil
+	case AlgorithmTokenBucket:
+		return NewTokenBucketRateLimiter(maxQPS, burst), nil
+	case AlgorithmSlidingWindow:
+		return NewSlidingWindowRateLimiter(maxQPS, burst), nil
+	}
+	return nil, fmt.Errorf("unknown rate limiting algorithm %s", algorithm)
+}
+
+// rateMeter keeps EMA of the accepted request rate and counters for limiters
+// that do not use EMA to make decisions
+type rateMeter struct {
+	timeOfLastRequest                           int64
+	avgWaitingNs                                int64
+	minWaitingNs                                int64
+	requestThrottledCount, requestAcceptedCount int64
+}
+
+func newRateMeter() rateMeter {
+	return rateMeter{
+		timeOfLastRequest: time.Now().UnixNano(),
+		avgWaitingNs:      1000000000000,
+		minWaitingNs:      1000000000000,
+	}
+}
+
+func (m *rateMeter) accepted(now int64) {
+	atomic.AddInt64(&m.requestAcceptedCount, 1)
+	instWaiting := now - atomic.SwapInt64(&m.timeOfLastRequest, now)
+	for {
+		avgWaitingNs := atomic.LoadInt64(&m.avgWaitingNs)
+		newavgWaitingNs := int64((1.-wq)*float64(avgWaitingNs) + wq*float64(instWaiting))
+		if newavgWaitingNs < 1 {
+			newavgWaitingNs = 1
+		}
+		if !atomic.CompareAndSwapInt64(&m.avgWaitingNs, avgWaitingNs, newavgWaitingNs) {
+			continue
+		}
+		for {
+			minWaitingNs := atomic.LoadInt64(&m.minWaitingNs)
+			if newavgWaitingNs >= minWaitingNs || atomic.CompareAndSwapInt64(&m.minWaitingNs, minWaitingNs, newavgWaitingNs) {
+				break
+			}
+		}
+		return
+	}
+}
+
+func (m *rateMeter) rejected() {
+	atomic.AddInt64(&m.requestThrottledCount, 1)
+}
+
+// MaxQPS returns max achieved EMA(QPS) since the rate limiter created
+func (m *rateMeter) MaxQPS() int64 {
+	return iNanosInSeconds / atomic.LoadInt64(&m.minWaitingNs)
+}
+
+// CurrentQPS returns current EMA of QPS
+func (m *rateMeter) CurrentQPS() int64 {
+	return iNanosInSeconds / atomic.LoadInt64(&m.avgWaitingNs)
+}
+
+// TotalAcceptedCount returs total number of accepted queries over the
+// limiter lifetime
+func (m *rateMeter) TotalAcceptedCount() int64 {
+	return atomic.LoadInt64(&m.requestAcceptedCount)
+}
+
+// TotalRejectedCount returs total number of rejected queries over the
+// limiter lifetime
+func (m *rateMeter) TotalRejectedCount() int64 {
+	return atomic.LoadInt64(&m.requestThrottledCount)
+}
+
+func (m *rateMeter) LastPacket() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&m.timeOfLastRequest))
+}
+
+// unlimitedRateLimiter accepts everything and only measures the rate
+type unlimitedRateLimiter struct {
+	rateMeter
+}
+
+func NewUnlimitedRateLimiter() RateLimiter {
+	return &unlimitedRateLimiter{rateMeter: newRateMeter()}
+}
+
+func (u *unlimitedRateLimiter) Accepted() bool {
+	u.accepted(time.Now().UnixNano())
+	return true
+}
+
+func (u *unlimitedRateLimiter) TargetQPS() int64 { return 0 }
+func (u *unlimitedRateLimiter) Unlimited() bool  { return true }
+
+// tokenBucketRateLimiter holds up to burst tokens and refills them at the target rate.
+// Every accepted query takes a token, so bursts up to the bucket size are always accepted
+// after a quiet period and the long term rate never exceeds the target.
+type tokenBucketRateLimiter struct {
+	rateMeter
+	mu          sync.Mutex
+	rate, burst float64
+	tokens      float64
+	last        int64
+}
+
+func NewTokenBucketRateLimiter(maxQPS float64, burst int) RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucketRateLimiter{
+		rateMeter: newRateMeter(),
+		rate:      maxQPS,
+		burst:     float64(burst),
+		tokens:    float64(burst),
+		last:      time.Now().UnixNano(),
+	}
+}
+
+func (t *tokenBucketRateLimiter) Accepted() bool {
+	now := time.Now().UnixNano()
+	t.mu.Lock()
+	t.tokens = math.Min(t.burst, t.tokens+float64(now-t.last)/nanosInSeconds*t.rate)
+	t.last = now
+	if t.tokens < 1 {
+		t.mu.Unlock()
+		t.rejected()
+		return false
+	}
+	t.tokens--
+	t.mu.Unlock()
+	t.accepted(now)
+	return true
+}
+
+// TargetQPS returns current refill rate
+func (t *tokenBucketRateLimiter) TargetQPS() int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return int64(t.rate)
+}
+
+func (t *tokenBucketRateLimiter) Unlimited() bool { return false }
+
+// setRate changes refill rate and bucket size keeping the tokens already collected
+func (t *tokenBucketRateLimiter) setRate(rate, burst float64) {
+	t.mu.Lock()
+	t.rate = rate
+	t.burst = math.Max(1, burst)
+	t.tokens = math.Min(t.tokens, t.burst)
+	t.mu.Unlock()
+}
+
+// slidingWindowRateLimiter remembers times of the last limit accepted queries and accepts
+// a query only if the oldest of them is older than the window. Unlike fixed windows,
+// no window of that length ever contains more than limit queries.
+type slidingWindowRateLimiter struct {
+	rateMeter
+	rate     float64 //final
+	windowNs int64   //final
+	mu       sync.Mutex
+	log      []int64 // ring buffer of accepted query times
+	next     int
+}
+
+// NewSlidingWindowRateLimiter allows burst queries in any window of burst/maxQPS seconds
+func NewSlidingWindowRateLimiter(maxQPS float64, burst int) RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &slidingWindowRateLimiter{
+		rateMeter: newRateMeter(),
+		rate:      maxQPS,
+		windowNs:  int64(float64(burst) / maxQPS * nanosInSeconds),
+		log:       make([]int64, burst),
+	}
+}
+
+func (s *slidingWindowRateLimiter) Accepted() bool {
+	now := time.Now().UnixNano()
+	s.mu.Lock()
+	if oldest := s.log[s.next]; oldest != 0 && now-oldest < s.windowNs {
+		s.mu.Unlock()
+		s.rejected()
+		return false
+	}
+	s.log[s.next] = now
+	s.next = (s.next + 1) % len(s.log)
+	s.mu.Unlock()
+	s.accepted(now)
+	return true
+}
+
+// TargetQPS returns configured average rate
+func (s *slidingWindowRateLimiter) TargetQPS() int64 { return int64(s.rate) }
+func (s *slidingWindowRateLimiter) Unlimited() bool  { return false }
@@ -0,0 +1,70 @@
+package stats
+
+import (
+	"testing"
+	"time"
+)
+
+func acceptedOf(rl RateLimiter, n int) int {
+	accepted := 0
+	for i := 0; i < n; i++ {
+		if rl.Accepted() {
+			accepted++
+		}
+	}
+	return accepted
+}
+
+func TestTokenBucket(t *testing.T) {
+	rl, err := NewRateLimiterAlgorithm(AlgorithmTokenBucket, 10, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := acceptedOf(rl, 20); n != 5 {
+		t.Errorf("expected burst of 5 to be accepted, got %d", n)
+	}
+	time.Sleep(250 * time.Millisecond)
+	if n := acceptedOf(rl, 20); n < 2 || n > 3 {
+		t.Errorf("expected 2-3 refilled tokens, got %d", n)
+	}
+	if rl.TotalRejectedCount() != int64(40-rl.TotalAcceptedCount()) || rl.Unlimited() || rl.TargetQPS() != 10 {
+		t.Error("unexpected counters")
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	// 3 requests in any 300ms window
+	rl, err := NewRateLimiterAlgorithm(AlgorithmSlidingWindow, 10, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := acceptedOf(rl, 10); n != 3 {
+		t.Errorf("expected 3 accepted, got %d", n)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if n := acceptedOf(rl, 10); n != 0 {
+		t.Errorf("expected window to be full, got %d accepted", n)
+	}
+	time.Sleep(250 * time.Millisecond)
+	if n := acceptedOf(rl, 10); n != 3 {
+		t.Errorf("expected window to slide, got %d accepted", n)
+	}
+}
+
+func TestUnlimited(t *testing.T) {
+	for _, alg := range []string{"", AlgorithmEMA, AlgorithmTokenBucket, AlgorithmSlidingWindow} {
+		rl, err := NewRateLimiterAlgorithm(alg, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rl.Unlimited() || acceptedOf(rl, 1000) != 1000 || rl.TotalAcceptedCount() != 1000 {
+			t.Errorf("%s: expected unlimited limiter", alg)
+		}
+	}
+	if NewRateLimiter(100).Unlimited() {
+		t.Error("EMA limiter is not unlimited")
+	}
+	if _, err := NewRateLimiterAlgorithm("leaky", 1, 1); err == nil {
+		t.Error("expected error for unknown algorithm")
+	}
+}
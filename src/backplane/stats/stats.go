@@ -1,10 +1,18 @@
 package stats
 
 import (
+	stdcontext "context"
 	"errors"
 	"fmt"
+	"io"
+	"math"
+	"net"
 	"net/http"
+	"strconv"
+	"sync"
 	"sync/atomic"
+	"syscall"
+	"time"
 
 	"github.com/apesternikov/backplane/src/context"
 
@@ -25,6 +33,7 @@ type Counters struct {
 	CurActiveSessions, MaxActiveSessions int64
 	TotalSessions                        int64
 	CountersByResponseCode               [6]int64 // 200 -> 2, 304 -> 3, 410 -> 4, 500->5
+	GrpcErrors                           int64    // gRPC responses with non-zero grpc-status
 }
 
 // return values from stats without locking.
@@ -42,6 +51,7 @@ func (s *Counters) atomicCopy() Counters {
 			atomic.LoadInt64(&s.CountersByResponseCode[4]),
 			atomic.LoadInt64(&s.CountersByResponseCode[5]),
 		},
+		GrpcErrors: atomic.LoadInt64(&s.GrpcErrors),
 	}
 }
 
@@ -68,6 +78,7 @@ type CountersCollectingHandler struct {
 	Handler     http.Handler
 	RateLimiter RateLimiter
 	Limiter     Limiter
+	Priority    int // if set, overrides request priority for this and downstream limiter queues
 	//TraceFamily string
 	stats Counters
 }
@@ -83,14 +94,42 @@ func (s *CountersCollectingHandler) ServeHTTP(w http.ResponseWriter, req *http.R
 			return
 		}
 	}
+	ctx := context.GetRequestContext(req)
+	var tr trace.Trace
+	if ctx != nil {
+		tr = ctx.Tr
+	}
 	if s.Limiter != nil {
-		ctx := context.GetRequestContext(req)
-		s.Limiter.Acquire(ctx.Tr)
-		defer s.Limiter.Release(ctx.Tr)
+		priority := s.Priority
+		if ctx != nil {
+			if s.Priority != 0 {
+				ctx.Priority = s.Priority
+			}
+			priority = ctx.Priority
+		}
+		if err := s.Limiter.AcquireQueued(req.Context(), tr, priority); err != nil {
+			w.Header().Set("Retry-After", strconv.Itoa(RetryAfter(s.Limiter)))
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+	}
+	// upgraded connections live on their own, they are counted by the proxy instead
+	var once sync.Once
+	done := func() {
+		once.Do(func() {
+			s.stats.out()
+			if s.Limiter != nil {
+				s.Limiter.Release(tr)
+			}
+		})
 	}
 	s.stats.in()
+	if ctx != nil {
+		ctx.OnUpgrade(done)
+	}
+	defer done()
 	s.Handler.ServeHTTP(w, req)
-	s.stats.out()
+	done()
 	if wr, ok := w.(*StatsCollectingResponseWriter); ok {
 		respBucket := wr.ResponseCode / 100
 		if respBucket == 0 {
@@ -99,7 +138,18 @@ func (s *CountersCollectingHandler) ServeHTTP(w http.ResponseWriter, req *http.R
 		if respBucket > 0 && respBucket <= 5 {
 			atomic.AddInt64(&s.stats.CountersByResponseCode[respBucket], 1)
 		}
+		if status := wr.GrpcStatus(); status != "" && status != "0" {
+			atomic.AddInt64(&s.stats.GrpcErrors, 1)
+		}
+	}
+}
+
+// RetryAfter suggests clients rejected by the limiter queue to come back after the queue timeout
+func RetryAfter(l Limiter) int {
+	if secs := int(math.Ceil(l.QueueTimeout().Seconds())); secs > 1 {
+		return secs
 	}
+	return 1
 }
 
 type CountersCollectingRoundTripper struct {
@@ -116,7 +166,43 @@ func (s *CountersCollectingRoundTripper) GetCounters() Counters {
 
 var RateLimited = errors.New("Rate Limited")
 
-func (s *CountersCollectingRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+// QueueError is returned by CountersCollectingRoundTripper when the request did not get through
+// the limiter queue. Err is QueueFull or QueueTimeout.
+type QueueError struct {
+	Err     error
+	Limiter Limiter
+}
+
+func (e *QueueError) Error() string { return e.Err.Error() }
+func (e *QueueError) Unwrap() error { return e.Err }
+
+// overloaded tells if the server failed the request because of overload: it answered 503,
+// timed out, refused or reset the connection. Other errors do not tell anything about the load.
+func overloaded(resp *http.Response, err error) bool {
+	if err == nil {
+		return resp.StatusCode == http.StatusServiceUnavailable
+	}
+	var ne net.Error
+	if errors.As(err, &ne) && ne.Timeout() || errors.Is(err, stdcontext.DeadlineExceeded) {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET)
+}
+
+// releasingBody releases the limiter allocation when the response body is closed
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+func (s *CountersCollectingRoundTripper) RoundTrip(r *http.Request) (resp *http.Response, err error) {
 	tr := trace.New(s.TraceFamily, r.RequestURI)
 	tr.LazyPrintf("Request: %#v", r)
 	defer tr.Finish()
@@ -127,9 +213,43 @@ func (s *CountersCollectingRoundTripper) RoundTrip(r *http.Request) (*http.Respo
 			return nil, RateLimited
 		}
 	}
+	var release func()
+	if s.Limiter != nil {
+		priority := 0
+		if ctx := context.GetRequestContext(r); ctx != nil {
+			priority = ctx.Priority
+		}
+		if err := s.Limiter.AcquireQueued(r.Context(), tr, priority); err != nil {
+			tr.SetError()
+			if err == QueueFull || err == QueueTimeout {
+				return nil, &QueueError{Err: err, Limiter: s.Limiter}
+			}
+			return nil, err
+		}
+		inflight := s.Limiter.Size()
+		observer, _ := s.Limiter.(LatencyObserver)
+		start := time.Now()
+		release = func() {
+			s.Limiter.Release(nil)
+		}
+		defer func() {
+			// requests cancelled by clients tell neither the latency nor the load of the server
+			if observer != nil && !errors.Is(err, stdcontext.Canceled) {
+				observer.Observe(time.Since(start), inflight, overloaded(resp, err))
+			}
+		}()
+	}
 	s.stats.in()
-	resp, err := s.RoundTripper.RoundTrip(r)
+	resp, err = s.RoundTripper.RoundTrip(r)
 	s.stats.out()
+	if release != nil {
+		// the server is busy until the response is read, upgraded connections are not limited
+		if resp != nil && err == nil && resp.Body != nil && resp.StatusCode != http.StatusSwitchingProtocols {
+			resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+		} else {
+			release()
+		}
+	}
 	if resp != nil && err == nil {
 		respBucket := resp.StatusCode / 100
 		if respBucket == 0 {
@@ -1,6 +1,10 @@
 package stats
 
 import (
+	"bufio"
+	"errors"
+	"net"
+
 	"github.com/apesternikov/backplane/src/context"
 
 	"net/http"
@@ -41,6 +45,28 @@ func (s *StatsCollectingResponseWriter) Flush() {
 	}
 }
 
+// Hijack lets protocol upgrades take over the client connection
+func (s *StatsCollectingResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := s.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("connection can not be hijacked")
+	}
+	return h.Hijack()
+}
+
 func (s *StatsCollectingResponseWriter) IsErrorResponse() bool {
+	if status := s.GrpcStatus(); status != "" && status != "0" {
+		return true
+	}
 	return s.ResponseCode != 0 && s.ResponseCode/100 != 2 && s.ResponseCode/100 != 3
 }
+
+// GrpcStatus returns grpc-status of gRPC response sent in headers or trailers, empty for other responses.
+// Trailers are available after the response is written.
+func (s *StatsCollectingResponseWriter) GrpcStatus() string {
+	h := s.Header()
+	if status := h.Get("Grpc-Status"); status != "" {
+		return status
+	}
+	return h.Get(http.TrailerPrefix + "Grpc-Status")
+}
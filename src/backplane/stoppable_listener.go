@@ -9,6 +9,8 @@ import (
 	"github.com/golang/glog"
 
 	"github.com/apesternikov/backplane/src/backplane/stats"
+	"github.com/apesternikov/backplane/src/ipacl"
+	"github.com/apesternikov/backplane/src/proxyproto"
 )
 
 // tcpKeepAliveListener sets TCP keep-alive timeouts on accepted
@@ -24,12 +26,16 @@ type StoppableListener struct {
 	RateLimiter       stats.RateLimiter
 	Limiter           stats.Limiter
 	BytesIn, BytesOut int64
+	ACL               *ipacl.ACL // if set, connections from denied peers are closed right after accept
+	AclRejectedCnt    int64
+	ProxyProtocolFrom *ipacl.Set // connections from these peers start with a PROXY protocol header
+	TrustedProxies    *ipacl.Set // peers passing the client address in a request header
 }
 
+// time allowed for the load balancer to send the PROXY header
+const proxyHeaderTimeout = 5 * time.Second
+
 func NewStoppableListener(l *net.TCPListener, maxrate float64, maxallowed int64) *StoppableListener {
-	if maxrate == 0 {
-		maxrate = 999999
-	}
 	return &StoppableListener{
 		TCPListener: l,
 		stop:        make(chan int),
@@ -97,6 +103,21 @@ func (sl *StoppableListener) Accept() (net.Conn, error) {
 				continue
 			}
 		}
+		var peer net.IP
+		if err == nil {
+			peer = tc.RemoteAddr().(*net.TCPAddr).IP
+		}
+		fromProxy := sl.ProxyProtocolFrom.Contains(peer)
+		// the real client of proxied connections is only known after the header is read,
+		// so they are checked per request
+		if err == nil && sl.ACL != nil && !fromProxy && !sl.TrustedProxies.Contains(peer) {
+			if allowed, _ := sl.ACL.Check(peer); !allowed {
+				glog.V(2).Infof("acl rejected connection from %s", tc.RemoteAddr())
+				atomic.AddInt64(&sl.AclRejectedCnt, 1)
+				tc.Close()
+				continue
+			}
+		}
 		if !sl.RateLimiter.Accepted() {
 			glog.Error("Acceptor QPS is too high, dropping connection")
 			tc.Close()
@@ -107,6 +128,9 @@ func (sl *StoppableListener) Accept() (net.Conn, error) {
 		tc.SetKeepAlive(true)
 		tc.SetKeepAlivePeriod(3 * time.Minute)
 
+		if fromProxy {
+			return &conn{Conn: proxyproto.NewConn(tc, proxyHeaderTimeout), parent: sl}, err
+		}
 		return &conn{Conn: tc, parent: sl}, err
 	}
 }
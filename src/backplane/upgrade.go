@@ -0,0 +1,198 @@
+package backplane
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/apesternikov/backplane/src/backplane/stats"
+	"github.com/apesternikov/backplane/src/context"
+	"github.com/golang/glog"
+)
+
+// idle timeout of upgraded connections if not configured
+const defaultUpgradeIdleTimeout = 10 * time.Minute
+
+// UpgradeCounters counts connections switched to another protocol, like WebSocket.
+// They are long lived, so they are counted apart from requests.
+type UpgradeCounters struct {
+	CurActive, MaxActive int64
+	Total                int64
+	BytesIn, BytesOut    int64 // from the client and to the client
+	IdleTimeouts         int64
+}
+
+// GetCounters returns values without locking, for informational purposes only
+func (c *UpgradeCounters) GetCounters() UpgradeCounters {
+	return UpgradeCounters{
+		CurActive:    atomic.LoadInt64(&c.CurActive),
+		MaxActive:    atomic.LoadInt64(&c.MaxActive),
+		Total:        atomic.LoadInt64(&c.Total),
+		BytesIn:      atomic.LoadInt64(&c.BytesIn),
+		BytesOut:     atomic.LoadInt64(&c.BytesOut),
+		IdleTimeouts: atomic.LoadInt64(&c.IdleTimeouts),
+	}
+}
+
+func (c *UpgradeCounters) in() {
+	atomic.AddInt64(&c.Total, 1)
+	active := atomic.AddInt64(&c.CurActive, 1)
+	for {
+		max := atomic.LoadInt64(&c.MaxActive)
+		if active <= max || atomic.CompareAndSwapInt64(&c.MaxActive, max, active) {
+			return
+		}
+	}
+}
+
+func (c *UpgradeCounters) out() {
+	atomic.AddInt64(&c.CurActive, -1)
+}
+
+// hasToken tells if comma separated header values contain the token
+func hasToken(values []string, token string) bool {
+	for _, v := range values {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// upgradeType returns the protocol requested in Upgrade header if Connection header asks for upgrade
+func upgradeType(h http.Header) string {
+	if hasToken(h["Connection"], "upgrade") {
+		return strings.ToLower(h.Get("Upgrade"))
+	}
+	return ""
+}
+
+// serveUpgrade completes the protocol switch accepted by the server: the client connection is
+// hijacked and spliced with the server connection returned as the body of 101 response.
+// Deadlines of the frontend server are cleared, the connection is closed after the idle timeout instead.
+// Once the 101 response is sent, the request releases limiter allocations and in-flight counters
+// of the handlers it passed: upgraded connections are not limited by maxconn, they are counted
+// by UpgradeCounters and closed when idle.
+func (p *ReverseProxy) serveUpgrade(rw http.ResponseWriter, req *http.Request, res *http.Response, reqUpType string) {
+	ctx := context.GetRequestContext(req)
+	fail := func(format string, args ...interface{}) {
+		glog.Infof("http: proxy upgrade error: "+format, args...)
+		if ctx != nil && ctx.Tr != nil {
+			ctx.Tr.LazyPrintf("http: proxy upgrade error: "+format, args...)
+		}
+		res.Body.Close()
+		rw.WriteHeader(http.StatusInternalServerError)
+	}
+	if resUpType := upgradeType(res.Header); reqUpType == "" || resUpType != reqUpType {
+		fail("server switched protocol to %q, requested %q", resUpType, reqUpType)
+		return
+	}
+	backConn, ok := res.Body.(io.ReadWriteCloser)
+	if !ok {
+		fail("server connection %T is not writable", res.Body)
+		return
+	}
+	hj, ok := rw.(http.Hijacker)
+	if !ok {
+		fail("client connection %T can not be hijacked", rw)
+		return
+	}
+	conn, brw, err := hj.Hijack()
+	if err != nil {
+		fail("%s", err)
+		return
+	}
+	var closeOnce sync.Once
+	closeBoth := func() {
+		closeOnce.Do(func() {
+			conn.Close()
+			backConn.Close()
+		})
+	}
+	defer closeBoth()
+	conn.SetDeadline(time.Time{})
+
+	if wr, ok := rw.(*stats.StatsCollectingResponseWriter); ok {
+		wr.ResponseCode = res.StatusCode
+	}
+	copyHeader(rw.Header(), res.Header)
+	res.Header = rw.Header()
+	res.Body = nil
+	if err := res.Write(brw); err == nil {
+		err = brw.Flush()
+	}
+	if err != nil {
+		glog.Infof("http: proxy upgrade error: %s", err)
+		return
+	}
+	if ctx != nil {
+		ctx.Upgraded()
+		if ctx.Tr != nil {
+			ctx.Tr.LazyPrintf("switched protocol to %s", reqUpType)
+		}
+	}
+
+	counters := p.Upgrades
+	if counters == nil {
+		counters = &UpgradeCounters{}
+	}
+	counters.in()
+	defer counters.out()
+	idle := p.UpgradeIdleTimeout
+	if idle <= 0 {
+		idle = defaultUpgradeIdleTimeout
+	}
+	lastActivity := time.Now().UnixNano()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		t := time.NewTimer(idle)
+		defer t.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-t.C:
+				since := time.Since(time.Unix(0, atomic.LoadInt64(&lastActivity)))
+				if since < idle {
+					t.Reset(idle - since)
+					continue
+				}
+				atomic.AddInt64(&counters.IdleTimeouts, 1)
+				closeBoth()
+				return
+			}
+		}
+	}()
+	splice := func(dst io.Writer, src io.Reader, bytes *int64, errc chan<- error) {
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := src.Read(buf)
+			if n > 0 {
+				atomic.StoreInt64(&lastActivity, time.Now().UnixNano())
+				if _, werr := dst.Write(buf[:n]); werr != nil {
+					errc <- werr
+					return
+				}
+				atomic.AddInt64(bytes, int64(n))
+			}
+			if err != nil {
+				errc <- err
+				return
+			}
+		}
+	}
+	errc := make(chan error, 2)
+	// the client may have sent data right after the request, it is buffered in brw
+	go splice(backConn, brw, &counters.BytesIn, errc)
+	go splice(conn, backConn, &counters.BytesOut, errc)
+	// either side closing ends the connection
+	<-errc
+	closeBoth()
+	<-errc
+}
@@ -0,0 +1,183 @@
+package backplane
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/apesternikov/backplane/src/config"
+)
+
+func TestUpgradeType(t *testing.T) {
+	for _, tc := range []struct {
+		connection, upgrade, expected string
+	}{
+		{"Upgrade", "websocket", "websocket"},
+		{"keep-alive, upgrade", "WebSocket", "websocket"},
+		{"close", "websocket", ""},
+		{"", "websocket", ""},
+	} {
+		h := http.Header{}
+		if tc.connection != "" {
+			h.Set("Connection", tc.connection)
+		}
+		h.Set("Upgrade", tc.upgrade)
+		if got := upgradeType(h); got != tc.expected {
+			t.Errorf("Connection %q Upgrade %q: expected %q got %q", tc.connection, tc.upgrade, tc.expected, got)
+		}
+	}
+}
+
+// echoUpgradeServer switches to "echo" protocol sending back every line
+func echoUpgradeServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if upgradeType(r.Header) != "echo" {
+			fmt.Fprint(w, "plain")
+			return
+		}
+		conn, brw, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		fmt.Fprint(brw, "HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: echo\r\n\r\n")
+		brw.Flush()
+		for {
+			line, err := brw.ReadString('\n')
+			if err != nil {
+				return
+			}
+			brw.WriteString(line)
+			brw.Flush()
+		}
+	}))
+}
+
+func upgradeFrontend(t *testing.T, be *Backend) *Frontend {
+	for i := 0; i < 100 && !be.Servers[0].IsHealthy(); i++ {
+		time.Sleep(20 * time.Millisecond)
+	}
+	f, err := NewFrontend(mustFEFromText(`
+		bind_http: "127.0.0.1:0"
+		host: <
+			default: true
+			handler: <
+				path: "/"
+				backend_name: "be1"
+				>
+			 >
+		`), func(name string) http.Handler { return be })
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	// upgraded connections must outlive request timeouts
+	f.srv.ReadTimeout = 50 * time.Millisecond
+	f.srv.WriteTimeout = 50 * time.Millisecond
+	if err := f.Listen(); err != nil {
+		t.Fatal(err)
+	}
+	go f.Serve()
+	return f
+}
+
+func dialUpgrade(t *testing.T, f *Frontend, early string) (net.Conn, *bufio.Reader) {
+	c, err := net.Dial("tcp", f.Sln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetDeadline(time.Now().Add(5 * time.Second))
+	fmt.Fprintf(c, "GET /ws HTTP/1.1\r\nHost: one.com\r\nConnection: Upgrade\r\nUpgrade: echo\r\n\r\n%s", early)
+	r := bufio.NewReader(c)
+	resp, err := http.ReadResponse(r, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols || resp.Header.Get("Upgrade") != "echo" {
+		t.Fatalf("unexpected response %d %v", resp.StatusCode, resp.Header)
+	}
+	return c, r
+}
+
+func TestUpgrade(t *testing.T) {
+	upstream := echoUpgradeServer(t)
+	defer upstream.Close()
+	be, err := NewBackend(&config.HttpBackend{
+		Name:    "be1",
+		Server:  []*config.Server{{Address: upstream.Listener.Addr().String()}},
+		Maxconn: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := upgradeFrontend(t, be)
+	defer f.Stop()
+
+	c, r := dialUpgrade(t, f, "hello\n")
+	defer c.Close()
+	if line, err := r.ReadString('\n'); err != nil || line != "hello\n" {
+		t.Fatalf("expected data sent with the request to be echoed, got %q %v", line, err)
+	}
+	time.Sleep(150 * time.Millisecond)
+	fmt.Fprint(c, "ping\n")
+	if line, err := r.ReadString('\n'); err != nil || line != "ping\n" {
+		t.Fatalf("expected connection to survive frontend timeouts, got %q %v", line, err)
+	}
+	cnt := be.Upgrades.GetCounters()
+	if cnt.CurActive != 1 || cnt.Total != 1 || cnt.BytesIn != 11 || cnt.BytesOut != 11 {
+		t.Errorf("unexpected counters %+v", cnt)
+	}
+	if size, cnt := be.Limiter.Size(), be.Counting.GetCounters(); size != 0 || cnt.CurActiveSessions != 0 {
+		t.Errorf("upgraded connection holds limiter %d or in-flight counter %d", size, cnt.CurActiveSessions)
+	}
+	// does not wait for maxconn taken by the first one
+	c2, r2 := dialUpgrade(t, f, "again\n")
+	defer c2.Close()
+	if line, err := r2.ReadString('\n'); err != nil || line != "again\n" {
+		t.Fatalf("expected second connection to be echoed, got %q %v", line, err)
+	}
+	c2.Close()
+	c.Close()
+	for i := 0; i < 100 && be.Upgrades.GetCounters().CurActive != 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if cnt := be.Upgrades.GetCounters(); cnt.CurActive != 0 || cnt.Total != 2 || cnt.IdleTimeouts != 0 {
+		t.Errorf("unexpected counters after close %+v", cnt)
+	}
+}
+
+func TestUpgradeIdleTimeout(t *testing.T) {
+	upstream := echoUpgradeServer(t)
+	defer upstream.Close()
+	be, err := NewBackend(&config.HttpBackend{
+		Name:                 "be1",
+		Server:               []*config.Server{{Address: upstream.Listener.Addr().String()}},
+		UpgradeIdleTimeoutMs: 200,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := upgradeFrontend(t, be)
+	defer f.Stop()
+
+	c, r := dialUpgrade(t, f, "")
+	defer c.Close()
+	for i := 0; i < 3; i++ {
+		time.Sleep(100 * time.Millisecond)
+		fmt.Fprint(c, "ping\n")
+		if line, err := r.ReadString('\n'); err != nil || line != "ping\n" {
+			t.Fatalf("expected active connection to stay open, got %q %v", line, err)
+		}
+	}
+	start := time.Now()
+	if _, err := r.ReadString('\n'); err == nil || time.Since(start) > time.Second {
+		t.Errorf("expected idle connection to be closed, got %v after %s", err, time.Since(start))
+	}
+	if cnt := be.Upgrades.GetCounters(); cnt.IdleTimeouts != 1 {
+		t.Errorf("unexpected counters %+v", cnt)
+	}
+}
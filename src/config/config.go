@@ -1,12 +1,67 @@
 package config
 
 import (
+	"encoding/base64"
 	"fmt"
+	"net"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 )
 
-var staticbackends = map[string]bool{"internalstats": true}
+var staticbackends = map[string]bool{"internalstats": true, "internalcluster": true}
+
+var rateAlgorithms = map[string]bool{"": true, "ema": true, "token_bucket": true, "sliding_window": true}
+
+func validateRateAlgorithm(where, algorithm string) error {
+	if !rateAlgorithms[algorithm] {
+		return fmt.Errorf("%s: unknown rate algorithm %s", where, algorithm)
+	}
+	return nil
+}
+
+// cluster-wide rates are always enforced by token bucket with the rate adjusted to the local share
+func validateClusterRate(where string, cluster bool, maxrate float64, algorithm string) error {
+	if !cluster {
+		return nil
+	}
+	if maxrate <= 0 {
+		return fmt.Errorf("%s: cluster_rate requires maxrate", where)
+	}
+	if algorithm != "" && algorithm != "token_bucket" {
+		return fmt.Errorf("%s: cluster_rate is not supported by rate algorithm %s", where, algorithm)
+	}
+	return nil
+}
+
+// the node is named by the bind address, so it must be a specific ip
+func validateCluster(c *Cluster) error {
+	host, _, err := net.SplitHostPort(c.Bind)
+	if err != nil {
+		return fmt.Errorf("cluster bind: %s", err)
+	}
+	if ip := net.ParseIP(host); ip == nil || ip.IsUnspecified() {
+		return fmt.Errorf("cluster bind %s is not a specific ip address", c.Bind)
+	}
+	for _, seed := range c.Seed {
+		if _, _, err := net.SplitHostPort(seed); err != nil {
+			return fmt.Errorf("cluster seed: %s", err)
+		}
+	}
+	for i, k := range c.EncryptKey {
+		key, err := base64.StdEncoding.DecodeString(k)
+		if err != nil {
+			return fmt.Errorf("cluster encrypt_key %d: %s", i+1, err)
+		}
+		if l := len(key); l != 16 && l != 24 && l != 32 {
+			return fmt.Errorf("cluster encrypt_key %d: key size %d is not 16, 24 or 32 bytes", i+1, l)
+		}
+	}
+	if c.ProbeIntervalMs < 0 || c.ProbeTimeoutMs < 0 || c.IndirectProbes < 0 || c.SuspicionMult < 0 {
+		return fmt.Errorf("cluster protocol parameters can not be negative")
+	}
+	return nil
+}
 
 //go:generate protoc --go_out=. config.proto
 //TODO: fail on duplicates
@@ -17,19 +72,92 @@ func Validate(cf *Config) error {
 			return fmt.Errorf("backend has empty name")
 		}
 		backends[b.Name] = b
+		if err := validateRateAlgorithm("backend "+b.Name, b.RateAlgorithm); err != nil {
+			return err
+		}
+		if err := validateClusterRate("backend "+b.Name, b.ClusterRate, b.Maxrate, b.RateAlgorithm); err != nil {
+			return err
+		}
+		for _, s := range b.Server {
+			if err := validateRateAlgorithm("server "+s.Address, s.RateAlgorithm); err != nil {
+				return err
+			}
+			if err := validateClusterRate("server "+s.Address, s.ClusterRate, s.Maxrate, s.RateAlgorithm); err != nil {
+				return err
+			}
+		}
+		if c := b.ClusterServers; c != nil {
+			if cf.Cluster == nil {
+				return fmt.Errorf("backend %s: cluster_servers require cluster config", b.Name)
+			}
+			if c.Tag != "" && strings.Index(c.Tag, "=") < 1 {
+				return fmt.Errorf("backend %s: cluster_servers tag %q is not key=value", b.Name, c.Tag)
+			}
+			if s := c.Server; s != nil {
+				if err := validateRateAlgorithm("backend "+b.Name+" cluster_servers", s.RateAlgorithm); err != nil {
+					return err
+				}
+				if err := validateClusterRate("backend "+b.Name+" cluster_servers", s.ClusterRate, s.Maxrate, s.RateAlgorithm); err != nil {
+					return err
+				}
+			}
+		}
+		switch b.Protocol {
+		case "", "http1":
+		case "h2c", "h2":
+			if b.SendProxyProtocol != 0 {
+				return fmt.Errorf("backend %s: send_proxy_protocol is not supported with protocol %s", b.Name, b.Protocol)
+			}
+		default:
+			return fmt.Errorf("backend %s: unknown protocol %s", b.Name, b.Protocol)
+		}
+		if a := b.ServerAdaptiveLimit; a != nil {
+			if a.Algorithm != "" && a.Algorithm != "gradient" && a.Algorithm != "aimd" {
+				return fmt.Errorf("backend %s: unknown adaptive limit algorithm %s", b.Name, a.Algorithm)
+			}
+			if a.MaxLimit != 0 && a.MaxLimit < a.MinLimit {
+				return fmt.Errorf("backend %s: adaptive max_limit is below min_limit", b.Name)
+			}
+		}
+	}
+	if c := cf.Cluster; c != nil {
+		if err := validateCluster(c); err != nil {
+			return err
+		}
 	}
 	for _, f := range cf.HttpFrontend {
+		if err := validateRateAlgorithm("frontend "+f.Name, f.ConnRateAlgorithm); err != nil {
+			return err
+		}
 		for _, h := range f.Host {
+			if err := validateRateAlgorithm("vhost", h.RateAlgorithm); err != nil {
+				return err
+			}
+			if err := validateClusterRate("vhost", h.ClusterRate, h.Maxrate, h.RateAlgorithm); err != nil {
+				return err
+			}
+			if r := h.HttpsRedirect; r != nil && r.Code != 0 && r.Code != 301 && r.Code != 308 {
+				return fmt.Errorf("https_redirect: unsupported redirect code %d", r.Code)
+			}
 			for _, b := range h.Handler {
 				if b.Path == "" {
 					return fmt.Errorf("binding with empty path")
 				}
+				if err := validateRateAlgorithm("binding "+b.Path, b.RateAlgorithm); err != nil {
+					return err
+				}
+				if err := validateClusterRate("binding "+b.Path, b.ClusterRate, b.Maxrate, b.RateAlgorithm); err != nil {
+					return err
+				}
 				if len(b.BackendName) == 0 {
 					return fmt.Errorf("binding %s has no backends configured", b.Path)
 				}
 				if backends[b.BackendName] == nil && !staticbackends[b.BackendName] {
 					return fmt.Errorf("binding %s: unknown backend %s", b.Path, b.BackendName)
 				}
+				if fw := b.GetAuth().GetForward(); fw != nil && backends[fw.BackendName] == nil && !staticbackends[fw.BackendName] {
+					return fmt.Errorf("binding %s: unknown forward auth backend %s", b.Path, fw.BackendName)
+				}
 			}
 
 		}
@@ -10,10 +10,17 @@ It is generated from these files:
 
 It has these top-level messages:
 	Auth
+	IpAcl
+	RateLimit
 	HttpHandler
+	HttpsRedirect
+	Hsts
 	HttpFrontend
 	Server
+	AdaptiveLimit
+	ClusterServers
 	HttpBackend
+	Cluster
 	Config
 */
 package config
@@ -25,6 +32,8 @@ var _ = proto.Marshal
 
 type Auth struct {
 	HttpBasic *AuthHttpBasicT `protobuf:"bytes,1,opt,name=http_basic" json:"http_basic,omitempty"`
+	Jwt       *AuthJwtT       `protobuf:"bytes,2,opt,name=jwt" json:"jwt,omitempty"`
+	Forward   *AuthForwardT   `protobuf:"bytes,3,opt,name=forward" json:"forward,omitempty"`
 }
 
 func (m *Auth) Reset()         { *m = Auth{} }
@@ -38,9 +47,27 @@ func (m *Auth) GetHttpBasic() *AuthHttpBasicT {
 	return nil
 }
 
+func (m *Auth) GetJwt() *AuthJwtT {
+	if m != nil {
+		return m.Jwt
+	}
+	return nil
+}
+
+func (m *Auth) GetForward() *AuthForwardT {
+	if m != nil {
+		return m.Forward
+	}
+	return nil
+}
+
 type AuthHttpBasicT struct {
-	Realm    string            `protobuf:"bytes,1,opt,name=realm" json:"realm,omitempty"`
-	Userpass map[string]string `protobuf:"bytes,2,rep,name=userpass" json:"userpass,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Realm          string            `protobuf:"bytes,1,opt,name=realm" json:"realm,omitempty"`
+	Userpass       map[string]string `protobuf:"bytes,2,rep,name=userpass" json:"userpass,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	HtpasswdFile   string            `protobuf:"bytes,3,opt,name=htpasswd_file" json:"htpasswd_file,omitempty"`
+	MaxFailures    int64             `protobuf:"varint,4,opt,name=max_failures" json:"max_failures,omitempty"`
+	LockoutSeconds int64             `protobuf:"varint,5,opt,name=lockout_seconds" json:"lockout_seconds,omitempty"`
+	UserHeader     string            `protobuf:"bytes,6,opt,name=user_header" json:"user_header,omitempty"`
 }
 
 func (m *AuthHttpBasicT) Reset()         { *m = AuthHttpBasicT{} }
@@ -54,13 +81,112 @@ func (m *AuthHttpBasicT) GetUserpass() map[string]string {
 	return nil
 }
 
+// Authorization: Bearer token validation
+type AuthJwtT struct {
+	Key              []string          `protobuf:"bytes,1,rep,name=key" json:"key,omitempty"`
+	HmacSecret       []string          `protobuf:"bytes,2,rep,name=hmac_secret" json:"hmac_secret,omitempty"`
+	JwksFile         string            `protobuf:"bytes,3,opt,name=jwks_file" json:"jwks_file,omitempty"`
+	JwksUrl          string            `protobuf:"bytes,4,opt,name=jwks_url" json:"jwks_url,omitempty"`
+	JwksCacheSeconds int64             `protobuf:"varint,5,opt,name=jwks_cache_seconds" json:"jwks_cache_seconds,omitempty"`
+	Issuer           []string          `protobuf:"bytes,6,rep,name=issuer" json:"issuer,omitempty"`
+	Audience         []string          `protobuf:"bytes,7,rep,name=audience" json:"audience,omitempty"`
+	LeewaySeconds    int64             `protobuf:"varint,8,opt,name=leeway_seconds" json:"leeway_seconds,omitempty"`
+	RequireClaim     map[string]string `protobuf:"bytes,9,rep,name=require_claim" json:"require_claim,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ForwardClaim     map[string]string `protobuf:"bytes,10,rep,name=forward_claim" json:"forward_claim,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Realm            string            `protobuf:"bytes,11,opt,name=realm" json:"realm,omitempty"`
+	AllowMissingExp  bool              `protobuf:"varint,12,opt,name=allow_missing_exp" json:"allow_missing_exp,omitempty"`
+}
+
+func (m *AuthJwtT) Reset()         { *m = AuthJwtT{} }
+func (m *AuthJwtT) String() string { return proto.CompactTextString(m) }
+func (*AuthJwtT) ProtoMessage()    {}
+
+func (m *AuthJwtT) GetRequireClaim() map[string]string {
+	if m != nil {
+		return m.RequireClaim
+	}
+	return nil
+}
+
+func (m *AuthJwtT) GetForwardClaim() map[string]string {
+	if m != nil {
+		return m.ForwardClaim
+	}
+	return nil
+}
+
+// subrequest to an external authorization service. 2xx allows the request, other responses are returned to the client
+type AuthForwardT struct {
+	BackendName    string   `protobuf:"bytes,1,opt,name=backend_name" json:"backend_name,omitempty"`
+	RequestHeader  []string `protobuf:"bytes,2,rep,name=request_header" json:"request_header,omitempty"`
+	ResponseHeader []string `protobuf:"bytes,3,rep,name=response_header" json:"response_header,omitempty"`
+	CacheSeconds   int64    `protobuf:"varint,4,opt,name=cache_seconds" json:"cache_seconds,omitempty"`
+	CacheKeyHeader string   `protobuf:"bytes,5,opt,name=cache_key_header" json:"cache_key_header,omitempty"`
+}
+
+func (m *AuthForwardT) Reset()         { *m = AuthForwardT{} }
+func (m *AuthForwardT) String() string { return proto.CompactTextString(m) }
+func (*AuthForwardT) ProtoMessage()    {}
+
+// client ip allow/deny lists. The most specific matching prefix decides. If nothing matches,
+// the client is denied when any allow rule is configured and allowed otherwise
+type IpAcl struct {
+	Allow     []string `protobuf:"bytes,1,rep,name=allow" json:"allow,omitempty"`
+	Deny      []string `protobuf:"bytes,2,rep,name=deny" json:"deny,omitempty"`
+	AllowFile []string `protobuf:"bytes,3,rep,name=allow_file" json:"allow_file,omitempty"`
+	DenyFile  []string `protobuf:"bytes,4,rep,name=deny_file" json:"deny_file,omitempty"`
+}
+
+func (m *IpAcl) Reset()         { *m = IpAcl{} }
+func (m *IpAcl) String() string { return proto.CompactTextString(m) }
+func (*IpAcl) ProtoMessage()    {}
+
+// per client rate limit. Every distinct key value gets its own token bucket, requests over
+// the limit are answered with 429 and Retry-After
+type RateLimit struct {
+	Key      string                        `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	KeyName  string                        `protobuf:"bytes,2,opt,name=key_name" json:"key_name,omitempty"`
+	Rate     float64                       `protobuf:"fixed64,3,opt,name=rate" json:"rate,omitempty"`
+	Burst    int64                         `protobuf:"varint,4,opt,name=burst" json:"burst,omitempty"`
+	MaxKeys  int64                         `protobuf:"varint,5,opt,name=max_keys" json:"max_keys,omitempty"`
+	Override map[string]*RateLimitOverride `protobuf:"bytes,6,rep,name=override" json:"override,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+
+func (m *RateLimit) GetOverride() map[string]*RateLimitOverride {
+	if m != nil {
+		return m.Override
+	}
+	return nil
+}
+
+type RateLimitOverride struct {
+	Rate  float64 `protobuf:"fixed64,1,opt,name=rate" json:"rate,omitempty"`
+	Burst int64   `protobuf:"varint,2,opt,name=burst" json:"burst,omitempty"`
+}
+
+func (m *RateLimitOverride) Reset()         { *m = RateLimitOverride{} }
+func (m *RateLimitOverride) String() string { return proto.CompactTextString(m) }
+func (*RateLimitOverride) ProtoMessage()    {}
+
 type HttpHandler struct {
 	// path matching rules are explained here http://golang.org/pkg/net/http/#ServeMux
-	Path        string  `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
-	BackendName string  `protobuf:"bytes,2,opt,name=backend_name" json:"backend_name,omitempty"`
-	Auth        *Auth   `protobuf:"bytes,3,opt,name=auth" json:"auth,omitempty"`
-	Maxconn     int64   `protobuf:"varint,4,opt,name=maxconn" json:"maxconn,omitempty"`
-	Maxrate     float64 `protobuf:"fixed64,5,opt,name=maxrate" json:"maxrate,omitempty"`
+	Path            string       `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	BackendName     string       `protobuf:"bytes,2,opt,name=backend_name" json:"backend_name,omitempty"`
+	Auth            *Auth        `protobuf:"bytes,3,opt,name=auth" json:"auth,omitempty"`
+	Maxconn         int64        `protobuf:"varint,4,opt,name=maxconn" json:"maxconn,omitempty"`
+	Maxrate         float64      `protobuf:"fixed64,5,opt,name=maxrate" json:"maxrate,omitempty"`
+	Acl             *IpAcl       `protobuf:"bytes,6,opt,name=acl" json:"acl,omitempty"`
+	ClientRateLimit []*RateLimit `protobuf:"bytes,7,rep,name=client_rate_limit" json:"client_rate_limit,omitempty"`
+	RateAlgorithm   string       `protobuf:"bytes,8,opt,name=rate_algorithm" json:"rate_algorithm,omitempty"`
+	RateBurst       int64        `protobuf:"varint,9,opt,name=rate_burst" json:"rate_burst,omitempty"`
+	Maxqueue        int64        `protobuf:"varint,10,opt,name=maxqueue" json:"maxqueue,omitempty"`
+	QueueTimeoutMs  int64        `protobuf:"varint,11,opt,name=queue_timeout_ms" json:"queue_timeout_ms,omitempty"`
+	Priority        int64        `protobuf:"varint,12,opt,name=priority" json:"priority,omitempty"`
+	ClusterRate     bool         `protobuf:"varint,13,opt,name=cluster_rate" json:"cluster_rate,omitempty"`
 }
 
 func (m *HttpHandler) Reset()         { *m = HttpHandler{} }
@@ -74,6 +200,42 @@ func (m *HttpHandler) GetAuth() *Auth {
 	return nil
 }
 
+func (m *HttpHandler) GetAcl() *IpAcl {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
+func (m *HttpHandler) GetClientRateLimit() []*RateLimit {
+	if m != nil {
+		return m.ClientRateLimit
+	}
+	return nil
+}
+
+// redirect plain http requests to https
+type HttpsRedirect struct {
+	Port       int64    `protobuf:"varint,1,opt,name=port" json:"port,omitempty"`
+	Code       int64    `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	ExemptPath []string `protobuf:"bytes,3,rep,name=exempt_path" json:"exempt_path,omitempty"`
+}
+
+func (m *HttpsRedirect) Reset()         { *m = HttpsRedirect{} }
+func (m *HttpsRedirect) String() string { return proto.CompactTextString(m) }
+func (*HttpsRedirect) ProtoMessage()    {}
+
+// HTTP Strict Transport Security policy, sent on https responses only
+type Hsts struct {
+	MaxAge            int64 `protobuf:"varint,1,opt,name=max_age" json:"max_age,omitempty"`
+	IncludeSubdomains bool  `protobuf:"varint,2,opt,name=include_subdomains" json:"include_subdomains,omitempty"`
+	Preload           bool  `protobuf:"varint,3,opt,name=preload" json:"preload,omitempty"`
+}
+
+func (m *Hsts) Reset()         { *m = Hsts{} }
+func (m *Hsts) String() string { return proto.CompactTextString(m) }
+func (*Hsts) ProtoMessage()    {}
+
 type HttpFrontend struct {
 	Name      string               `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	BindHttp  string               `protobuf:"bytes,2,opt,name=bind_http" json:"bind_http,omitempty"`
@@ -81,12 +243,22 @@ type HttpFrontend struct {
 	BindHttps string               `protobuf:"bytes,4,opt,name=bind_https" json:"bind_https,omitempty"`
 	SslCert   []string             `protobuf:"bytes,5,rep,name=ssl_cert" json:"ssl_cert,omitempty"`
 	// TODO: check if pattern is malformed
-	SslCertMask    string  `protobuf:"bytes,7,opt,name=ssl_cert_mask" json:"ssl_cert_mask,omitempty"`
-	ServerString   string  `protobuf:"bytes,8,opt,name=server_string" json:"server_string,omitempty"`
-	MaxConnRate    float64 `protobuf:"fixed64,9,opt,name=max_conn_rate" json:"max_conn_rate,omitempty"`
-	SslMaxConnRate float64 `protobuf:"fixed64,10,opt,name=ssl_max_conn_rate" json:"ssl_max_conn_rate,omitempty"`
-	MaxConns       int64   `protobuf:"varint,11,opt,name=max_conns" json:"max_conns,omitempty"`
-	SslMaxConns    int64   `protobuf:"varint,12,opt,name=ssl_max_conns" json:"ssl_max_conns,omitempty"`
+	SslCertMask         string   `protobuf:"bytes,7,opt,name=ssl_cert_mask" json:"ssl_cert_mask,omitempty"`
+	ServerString        string   `protobuf:"bytes,8,opt,name=server_string" json:"server_string,omitempty"`
+	MaxConnRate         float64  `protobuf:"fixed64,9,opt,name=max_conn_rate" json:"max_conn_rate,omitempty"`
+	SslMaxConnRate      float64  `protobuf:"fixed64,10,opt,name=ssl_max_conn_rate" json:"ssl_max_conn_rate,omitempty"`
+	MaxConns            int64    `protobuf:"varint,11,opt,name=max_conns" json:"max_conns,omitempty"`
+	SslMaxConns         int64    `protobuf:"varint,12,opt,name=ssl_max_conns" json:"ssl_max_conns,omitempty"`
+	OcspStapling        bool     `protobuf:"varint,13,opt,name=ocsp_stapling" json:"ocsp_stapling,omitempty"`
+	OcspCacheDir        string   `protobuf:"bytes,14,opt,name=ocsp_cache_dir" json:"ocsp_cache_dir,omitempty"`
+	Acl                 *IpAcl   `protobuf:"bytes,15,opt,name=acl" json:"acl,omitempty"`
+	AclOnAccept         bool     `protobuf:"varint,16,opt,name=acl_on_accept" json:"acl_on_accept,omitempty"`
+	AcceptProxyProtocol bool     `protobuf:"varint,17,opt,name=accept_proxy_protocol" json:"accept_proxy_protocol,omitempty"`
+	ProxyProtocolFrom   []string `protobuf:"bytes,18,rep,name=proxy_protocol_from" json:"proxy_protocol_from,omitempty"`
+	TrustedProxies      []string `protobuf:"bytes,19,rep,name=trusted_proxies" json:"trusted_proxies,omitempty"`
+	ClientIpHeader      string   `protobuf:"bytes,20,opt,name=client_ip_header" json:"client_ip_header,omitempty"`
+	ConnRateAlgorithm   string   `protobuf:"bytes,21,opt,name=conn_rate_algorithm" json:"conn_rate_algorithm,omitempty"`
+	ConnRateBurst       int64    `protobuf:"varint,22,opt,name=conn_rate_burst" json:"conn_rate_burst,omitempty"`
 }
 
 func (m *HttpFrontend) Reset()         { *m = HttpFrontend{} }
@@ -100,12 +272,28 @@ func (m *HttpFrontend) GetHost() []*HttpFrontendVhost {
 	return nil
 }
 
+func (m *HttpFrontend) GetAcl() *IpAcl {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
 type HttpFrontendVhost struct {
-	Default bool           `protobuf:"varint,1,opt,name=default" json:"default,omitempty"`
-	Domain  []string       `protobuf:"bytes,2,rep,name=domain" json:"domain,omitempty"`
-	Handler []*HttpHandler `protobuf:"bytes,3,rep,name=handler" json:"handler,omitempty"`
-	Maxconn int64          `protobuf:"varint,4,opt,name=maxconn" json:"maxconn,omitempty"`
-	Maxrate float64        `protobuf:"fixed64,5,opt,name=maxrate" json:"maxrate,omitempty"`
+	Default         bool           `protobuf:"varint,1,opt,name=default" json:"default,omitempty"`
+	Domain          []string       `protobuf:"bytes,2,rep,name=domain" json:"domain,omitempty"`
+	Handler         []*HttpHandler `protobuf:"bytes,3,rep,name=handler" json:"handler,omitempty"`
+	Maxconn         int64          `protobuf:"varint,4,opt,name=maxconn" json:"maxconn,omitempty"`
+	Maxrate         float64        `protobuf:"fixed64,5,opt,name=maxrate" json:"maxrate,omitempty"`
+	HttpsRedirect   *HttpsRedirect `protobuf:"bytes,6,opt,name=https_redirect" json:"https_redirect,omitempty"`
+	Hsts            *Hsts          `protobuf:"bytes,7,opt,name=hsts" json:"hsts,omitempty"`
+	Acl             *IpAcl         `protobuf:"bytes,8,opt,name=acl" json:"acl,omitempty"`
+	ClientRateLimit []*RateLimit   `protobuf:"bytes,9,rep,name=client_rate_limit" json:"client_rate_limit,omitempty"`
+	RateAlgorithm   string         `protobuf:"bytes,10,opt,name=rate_algorithm" json:"rate_algorithm,omitempty"`
+	RateBurst       int64          `protobuf:"varint,11,opt,name=rate_burst" json:"rate_burst,omitempty"`
+	Maxqueue        int64          `protobuf:"varint,12,opt,name=maxqueue" json:"maxqueue,omitempty"`
+	QueueTimeoutMs  int64          `protobuf:"varint,13,opt,name=queue_timeout_ms" json:"queue_timeout_ms,omitempty"`
+	ClusterRate     bool           `protobuf:"varint,14,opt,name=cluster_rate" json:"cluster_rate,omitempty"`
 }
 
 func (m *HttpFrontendVhost) Reset()         { *m = HttpFrontendVhost{} }
@@ -119,22 +307,97 @@ func (m *HttpFrontendVhost) GetHandler() []*HttpHandler {
 	return nil
 }
 
+func (m *HttpFrontendVhost) GetHttpsRedirect() *HttpsRedirect {
+	if m != nil {
+		return m.HttpsRedirect
+	}
+	return nil
+}
+
+func (m *HttpFrontendVhost) GetHsts() *Hsts {
+	if m != nil {
+		return m.Hsts
+	}
+	return nil
+}
+
+func (m *HttpFrontendVhost) GetAcl() *IpAcl {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
+func (m *HttpFrontendVhost) GetClientRateLimit() []*RateLimit {
+	if m != nil {
+		return m.ClientRateLimit
+	}
+	return nil
+}
+
 type Server struct {
-	Address string  `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
-	Weight  int64   `protobuf:"varint,2,opt,name=weight" json:"weight,omitempty"`
-	Maxconn int64   `protobuf:"varint,3,opt,name=maxconn" json:"maxconn,omitempty"`
-	Maxrate float64 `protobuf:"fixed64,4,opt,name=maxrate" json:"maxrate,omitempty"`
+	Address       string  `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Weight        int64   `protobuf:"varint,2,opt,name=weight" json:"weight,omitempty"`
+	Maxconn       int64   `protobuf:"varint,3,opt,name=maxconn" json:"maxconn,omitempty"`
+	Maxrate       float64 `protobuf:"fixed64,4,opt,name=maxrate" json:"maxrate,omitempty"`
+	RateAlgorithm string  `protobuf:"bytes,5,opt,name=rate_algorithm" json:"rate_algorithm,omitempty"`
+	RateBurst     int64   `protobuf:"varint,6,opt,name=rate_burst" json:"rate_burst,omitempty"`
+	ClusterRate   bool    `protobuf:"varint,7,opt,name=cluster_rate" json:"cluster_rate,omitempty"`
 }
 
 func (m *Server) Reset()         { *m = Server{} }
 func (m *Server) String() string { return proto.CompactTextString(m) }
 func (*Server) ProtoMessage()    {}
 
+// concurrency limit adjusted to the observed server latency
+type AdaptiveLimit struct {
+	Algorithm      string  `protobuf:"bytes,1,opt,name=algorithm" json:"algorithm,omitempty"`
+	MinLimit       int64   `protobuf:"varint,2,opt,name=min_limit" json:"min_limit,omitempty"`
+	MaxLimit       int64   `protobuf:"varint,3,opt,name=max_limit" json:"max_limit,omitempty"`
+	InitialLimit   int64   `protobuf:"varint,4,opt,name=initial_limit" json:"initial_limit,omitempty"`
+	BackoffRatio   float64 `protobuf:"fixed64,5,opt,name=backoff_ratio" json:"backoff_ratio,omitempty"`
+	Maxqueue       int64   `protobuf:"varint,6,opt,name=maxqueue" json:"maxqueue,omitempty"`
+	QueueTimeoutMs int64   `protobuf:"varint,7,opt,name=queue_timeout_ms" json:"queue_timeout_ms,omitempty"`
+}
+
+func (m *AdaptiveLimit) Reset()         { *m = AdaptiveLimit{} }
+func (m *AdaptiveLimit) String() string { return proto.CompactTextString(m) }
+func (*AdaptiveLimit) ProtoMessage()    {}
+
+// servers discovered among live cluster members
+type ClusterServers struct {
+	Tag         string  `protobuf:"bytes,1,opt,name=tag" json:"tag,omitempty"`
+	AddressMeta string  `protobuf:"bytes,2,opt,name=address_meta" json:"address_meta,omitempty"`
+	Server      *Server `protobuf:"bytes,3,opt,name=server" json:"server,omitempty"`
+}
+
+func (m *ClusterServers) Reset()         { *m = ClusterServers{} }
+func (m *ClusterServers) String() string { return proto.CompactTextString(m) }
+func (*ClusterServers) ProtoMessage()    {}
+
+func (m *ClusterServers) GetServer() *Server {
+	if m != nil {
+		return m.Server
+	}
+	return nil
+}
+
 type HttpBackend struct {
-	Name    string    `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Server  []*Server `protobuf:"bytes,2,rep,name=server" json:"server,omitempty"`
-	Maxconn int64     `protobuf:"varint,3,opt,name=maxconn" json:"maxconn,omitempty"`
-	Maxrate float64   `protobuf:"fixed64,4,opt,name=maxrate" json:"maxrate,omitempty"`
+	Name                 string          `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Server               []*Server       `protobuf:"bytes,2,rep,name=server" json:"server,omitempty"`
+	Maxconn              int64           `protobuf:"varint,3,opt,name=maxconn" json:"maxconn,omitempty"`
+	Maxrate              float64         `protobuf:"fixed64,4,opt,name=maxrate" json:"maxrate,omitempty"`
+	SendProxyProtocol    int64           `protobuf:"varint,5,opt,name=send_proxy_protocol" json:"send_proxy_protocol,omitempty"`
+	RateAlgorithm        string          `protobuf:"bytes,6,opt,name=rate_algorithm" json:"rate_algorithm,omitempty"`
+	RateBurst            int64           `protobuf:"varint,7,opt,name=rate_burst" json:"rate_burst,omitempty"`
+	Maxqueue             int64           `protobuf:"varint,8,opt,name=maxqueue" json:"maxqueue,omitempty"`
+	QueueTimeoutMs       int64           `protobuf:"varint,9,opt,name=queue_timeout_ms" json:"queue_timeout_ms,omitempty"`
+	ServerAdaptiveLimit  *AdaptiveLimit  `protobuf:"bytes,10,opt,name=server_adaptive_limit" json:"server_adaptive_limit,omitempty"`
+	ClusterRate          bool            `protobuf:"varint,11,opt,name=cluster_rate" json:"cluster_rate,omitempty"`
+	UpgradeIdleTimeoutMs int64           `protobuf:"varint,12,opt,name=upgrade_idle_timeout_ms" json:"upgrade_idle_timeout_ms,omitempty"`
+	Protocol             string          `protobuf:"bytes,13,opt,name=protocol" json:"protocol,omitempty"`
+	TlsSkipVerify        bool            `protobuf:"varint,14,opt,name=tls_skip_verify" json:"tls_skip_verify,omitempty"`
+	ClusterServers       *ClusterServers `protobuf:"bytes,15,opt,name=cluster_servers" json:"cluster_servers,omitempty"`
 }
 
 func (m *HttpBackend) Reset()         { *m = HttpBackend{} }
@@ -148,9 +411,46 @@ func (m *HttpBackend) GetServer() []*Server {
 	return nil
 }
 
+func (m *HttpBackend) GetServerAdaptiveLimit() *AdaptiveLimit {
+	if m != nil {
+		return m.ServerAdaptiveLimit
+	}
+	return nil
+}
+
+func (m *HttpBackend) GetClusterServers() *ClusterServers {
+	if m != nil {
+		return m.ClusterServers
+	}
+	return nil
+}
+
+type Cluster struct {
+	Bind            string            `protobuf:"bytes,1,opt,name=bind" json:"bind,omitempty"`
+	Seed            []string          `protobuf:"bytes,2,rep,name=seed" json:"seed,omitempty"`
+	Tags            map[string]string `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	EncryptKey      []string          `protobuf:"bytes,4,rep,name=encrypt_key" json:"encrypt_key,omitempty"`
+	ProbeIntervalMs int64             `protobuf:"varint,5,opt,name=probe_interval_ms" json:"probe_interval_ms,omitempty"`
+	ProbeTimeoutMs  int64             `protobuf:"varint,6,opt,name=probe_timeout_ms" json:"probe_timeout_ms,omitempty"`
+	IndirectProbes  int64             `protobuf:"varint,7,opt,name=indirect_probes" json:"indirect_probes,omitempty"`
+	SuspicionMult   int64             `protobuf:"varint,8,opt,name=suspicion_mult" json:"suspicion_mult,omitempty"`
+}
+
+func (m *Cluster) Reset()         { *m = Cluster{} }
+func (m *Cluster) String() string { return proto.CompactTextString(m) }
+func (*Cluster) ProtoMessage()    {}
+
+func (m *Cluster) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 type Config struct {
 	HttpFrontend []*HttpFrontend `protobuf:"bytes,1,rep,name=http_frontend" json:"http_frontend,omitempty"`
 	HttpBackend  []*HttpBackend  `protobuf:"bytes,2,rep,name=http_backend" json:"http_backend,omitempty"`
+	Cluster      *Cluster        `protobuf:"bytes,3,opt,name=cluster" json:"cluster,omitempty"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -171,5 +471,12 @@ func (m *Config) GetHttpBackend() []*HttpBackend {
 	return nil
 }
 
+func (m *Config) GetCluster() *Cluster {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
 func init() {
 }
@@ -15,8 +15,23 @@ type key int
 var mykey key
 
 type RequestContext struct {
-	Log *requestlog.Item
-	Tr  trace.Trace
+	Log      *requestlog.Item
+	Tr       trace.Trace
+	Priority int // position in limiter queues, higher is served first
+	upgraded []func()
+}
+
+// OnUpgrade registers f to be called when the connection is switched to another protocol
+func (c *RequestContext) OnUpgrade(f func()) {
+	c.upgraded = append(c.upgraded, f)
+}
+
+// Upgraded calls functions registered with OnUpgrade, innermost first
+func (c *RequestContext) Upgraded() {
+	for i := len(c.upgraded) - 1; i >= 0; i-- {
+		c.upgraded[i]()
+	}
+	c.upgraded = nil
 }
 
 // NewRequestContext attaches request context to http request
@@ -0,0 +1,56 @@
+// Package filewatch detects modifications of config-like files on disk
+// (htpasswd files, ip lists) so they can be reloaded without restart.
+package filewatch
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// File tracks modification time and size of a file. Checks are rate limited by
+// Interval so it is cheap enough to call Changed on every request.
+type File struct {
+	Path     string
+	Interval time.Duration // min interval between checks, 1s if not set
+
+	mu        sync.Mutex
+	lastCheck time.Time
+	modTime   time.Time
+	size      int64
+	seen      bool
+}
+
+func New(path string) *File {
+	return &File{Path: path}
+}
+
+// Changed returns true on the first call and every time the file is modified afterwards.
+// Stat errors are logged and reported as no change, so the caller keeps the last good content.
+func (f *File) Changed() bool {
+	interval := f.Interval
+	if interval == 0 {
+		interval = time.Second
+	}
+	now := time.Now()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.lastCheck.IsZero() && now.Sub(f.lastCheck) < interval {
+		return false
+	}
+	f.lastCheck = now
+	fi, err := os.Stat(f.Path)
+	if err != nil {
+		glog.Errorf("Unable to stat %s: %s", f.Path, err)
+		return false
+	}
+	if f.seen && fi.ModTime().Equal(f.modTime) && fi.Size() == f.size {
+		return false
+	}
+	f.seen = true
+	f.modTime = fi.ModTime()
+	f.size = fi.Size()
+	return true
+}
@@ -13,6 +13,8 @@ It has these top-level messages:
 	Ack
 	PingReq
 	DisseminationUpdateMsg
+	RateUsage
+	RateReport
 	SwimMessage
 */
 package gen
@@ -48,17 +50,78 @@ func (m *PingReq) Reset()         { *m = PingReq{} }
 func (m *PingReq) String() string { return proto.CompactTextString(m) }
 func (*PingReq) ProtoMessage()    {}
 
+type NodeState int32
+
+const (
+	NodeState_ALIVE   NodeState = 0
+	NodeState_SUSPECT NodeState = 1
+	NodeState_DEAD    NodeState = 2
+)
+
+var NodeState_name = map[int32]string{
+	0: "ALIVE",
+	1: "SUSPECT",
+	2: "DEAD",
+}
+var NodeState_value = map[string]int32{
+	"ALIVE":   0,
+	"SUSPECT": 1,
+	"DEAD":    2,
+}
+
+func (x NodeState) String() string {
+	return proto.EnumName(NodeState_name, int32(x))
+}
+
 type DisseminationUpdateMsg struct {
-	Timestamp int64  `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
-	NodeName  string `protobuf:"bytes,2,opt,name=node_name" json:"node_name,omitempty"`
-	Alive     bool   `protobuf:"varint,3,opt,name=alive" json:"alive,omitempty"`
-	Origin    string `protobuf:"bytes,4,opt,name=origin" json:"origin,omitempty"`
+	Timestamp   int64             `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	NodeName    string            `protobuf:"bytes,2,opt,name=node_name" json:"node_name,omitempty"`
+	Alive       bool              `protobuf:"varint,3,opt,name=alive" json:"alive,omitempty"`
+	Origin      string            `protobuf:"bytes,4,opt,name=origin" json:"origin,omitempty"`
+	State       NodeState         `protobuf:"varint,5,opt,name=state,enum=gen.NodeState" json:"state,omitempty"`
+	Incarnation int64             `protobuf:"varint,6,opt,name=incarnation" json:"incarnation,omitempty"`
+	Meta        map[string]string `protobuf:"bytes,7,rep,name=meta" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *DisseminationUpdateMsg) Reset()         { *m = DisseminationUpdateMsg{} }
 func (m *DisseminationUpdateMsg) String() string { return proto.CompactTextString(m) }
 func (*DisseminationUpdateMsg) ProtoMessage()    {}
 
+func (m *DisseminationUpdateMsg) GetMeta() map[string]string {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+// demand of a cluster-wide rate limit on the reporting node
+type RateUsage struct {
+	Limiter string  `protobuf:"bytes,1,opt,name=limiter" json:"limiter,omitempty"`
+	Rate    float64 `protobuf:"fixed64,2,opt,name=rate" json:"rate,omitempty"`
+}
+
+func (m *RateUsage) Reset()         { *m = RateUsage{} }
+func (m *RateUsage) String() string { return proto.CompactTextString(m) }
+func (*RateUsage) ProtoMessage()    {}
+
+// latest demand of all cluster-wide rate limits of a node
+type RateReport struct {
+	NodeName  string       `protobuf:"bytes,1,opt,name=node_name" json:"node_name,omitempty"`
+	Timestamp int64        `protobuf:"varint,2,opt,name=timestamp" json:"timestamp,omitempty"`
+	Usage     []*RateUsage `protobuf:"bytes,3,rep,name=usage" json:"usage,omitempty"`
+}
+
+func (m *RateReport) Reset()         { *m = RateReport{} }
+func (m *RateReport) String() string { return proto.CompactTextString(m) }
+func (*RateReport) ProtoMessage()    {}
+
+func (m *RateReport) GetUsage() []*RateUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 type SwimMessage struct {
 	Seq int64 `protobuf:"varint,1,opt,name=seq" json:"seq,omitempty"`
 	// one of the following
@@ -69,6 +132,10 @@ type SwimMessage struct {
 	DisseminationUpdates []*DisseminationUpdateMsg `protobuf:"bytes,5,rep,name=dissemination_updates" json:"dissemination_updates,omitempty"`
 	SourceSeq            int64                     `protobuf:"varint,6,opt,name=source_seq" json:"source_seq,omitempty"`
 	KnownDestSeq         int64                     `protobuf:"varint,7,opt,name=known_dest_seq" json:"known_dest_seq,omitempty"`
+	RateReports          []*RateReport             `protobuf:"bytes,8,rep,name=rate_reports" json:"rate_reports,omitempty"`
+	// response to known_dest_seq: log entries after it up to source_seq, in order
+	LogUpdates []*DisseminationUpdateMsg `protobuf:"bytes,9,rep,name=log_updates" json:"log_updates,omitempty"`
+	Behind     bool                      `protobuf:"varint,10,opt,name=behind" json:"behind,omitempty"`
 }
 
 func (m *SwimMessage) Reset()         { *m = SwimMessage{} }
@@ -103,5 +170,20 @@ func (m *SwimMessage) GetDisseminationUpdates() []*DisseminationUpdateMsg {
 	return nil
 }
 
+func (m *SwimMessage) GetRateReports() []*RateReport {
+	if m != nil {
+		return m.RateReports
+	}
+	return nil
+}
+
+func (m *SwimMessage) GetLogUpdates() []*DisseminationUpdateMsg {
+	if m != nil {
+		return m.LogUpdates
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("gen.NodeState", NodeState_name, NodeState_value)
 }
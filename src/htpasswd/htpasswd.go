@@ -0,0 +1,167 @@
+// Package htpasswd verifies passwords against hashes used in apache htpasswd files:
+// bcrypt, SHA-256/SHA-512 crypt, MD5 crypt ($apr1$ and $1$) and {SHA}.
+// Plain text passwords are accepted only in the inline configuration.
+// All comparisons are done in constant time.
+package htpasswd
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/apesternikov/backplane/src/filewatch"
+
+	"github.com/golang/glog"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Verify checks the password against the hash. The scheme is detected by the hash prefix,
+// hashes of unknown schemes never match.
+func Verify(hash, password string) bool {
+	switch {
+	case strings.HasPrefix(hash, "$apr1$"), strings.HasPrefix(hash, "$1$"):
+		computed, err := Md5Crypt(password, hash)
+		if err != nil {
+			return false
+		}
+		return constantTimeEqual(computed, hash)
+	case strings.HasPrefix(hash, "$2a$"), strings.HasPrefix(hash, "$2b$"), strings.HasPrefix(hash, "$2y$"):
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+	case strings.HasPrefix(hash, "$5$"), strings.HasPrefix(hash, "$6$"):
+		computed, err := ShaCrypt(password, hash)
+		if err != nil {
+			return false
+		}
+		return constantTimeEqual(computed, hash)
+	case strings.HasPrefix(hash, "{SHA}"):
+		sum := sha1.Sum([]byte(password))
+		return constantTimeEqual("{SHA}"+base64.StdEncoding.EncodeToString(sum[:]), hash)
+	default:
+		return false
+	}
+}
+
+// Supported tells if Verify recognizes the scheme of the hash
+func Supported(hash string) bool {
+	for _, prefix := range []string{"$2a$", "$2b$", "$2y$", "$5$", "$6$", "$apr1$", "$1$", "{SHA}"} {
+		if strings.HasPrefix(hash, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// value looks like having a scheme prefix, "$scheme$" or "{SCHEME}"
+func hasScheme(hash string) bool {
+	switch {
+	case strings.HasPrefix(hash, "$"):
+		return strings.Index(hash[1:], "$") > 0
+	case strings.HasPrefix(hash, "{"):
+		return strings.Index(hash, "}") > 1
+	}
+	return false
+}
+
+// VerifyInline checks the password against the value of the inline configuration,
+// which is either a hash or a plain text password without a scheme prefix.
+func VerifyInline(value, password string) bool {
+	if hasScheme(value) {
+		return Verify(value, password)
+	}
+	return constantTimeEqual(password, value)
+}
+
+// compare digests rather than strings so the time does not depend on the length of the secret
+func constantTimeEqual(a, b string) bool {
+	ha := sha256.Sum256([]byte(a))
+	hb := sha256.Sum256([]byte(b))
+	return subtle.ConstantTimeCompare(ha[:], hb[:]) == 1
+}
+
+// bcrypt hash of a random password, used for unknown users to spend the same time as for known ones
+var dummyHash = "$2a$10$lb5T.ass7dv9EJA891MlR.gK19.J0YXHLvPU78axEXUV8UK3Yh1Z2"
+
+// VerifyMissing spends about the same time as Verify does for bcrypt hashes and always returns false.
+// It should be called when the user is unknown to avoid leaking user existence through timing.
+func VerifyMissing(password string) bool {
+	bcrypt.CompareHashAndPassword([]byte(dummyHash), []byte(password))
+	return false
+}
+
+// Parse reads htpasswd formatted user:hash lines. Empty lines and lines starting with # are skipped.
+func Parse(data []byte) (map[string]string, error) {
+	users := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineno := 1; scanner.Scan(); lineno++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		idx := strings.Index(line, ":")
+		if idx <= 0 {
+			return nil, fmt.Errorf("line %d: expected user:hash", lineno)
+		}
+		users[line[:idx]] = line[idx+1:]
+	}
+	return users, scanner.Err()
+}
+
+// File is a htpasswd file reloaded when modified on disk.
+type File struct {
+	watch *filewatch.File
+	mu    sync.RWMutex
+	users map[string]string
+}
+
+// Open loads the file. Later reloads that fail keep the previously loaded users.
+func Open(path string) (*File, error) {
+	f := &File{watch: filewatch.New(path)}
+	f.watch.Changed()
+	if err := f.load(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *File) load() error {
+	data, err := ioutil.ReadFile(f.watch.Path)
+	if err != nil {
+		return err
+	}
+	users, err := Parse(data)
+	if err != nil {
+		return fmt.Errorf("%s: %s", f.watch.Path, err)
+	}
+	// plain text and unknown schemes would be compared as plain text by mistake, so they are not allowed
+	for user, hash := range users {
+		if !Supported(hash) {
+			glog.Errorf("%s: ignoring user %s with unsupported password hash", f.watch.Path, user)
+			delete(users, user)
+		}
+	}
+	f.mu.Lock()
+	f.users = users
+	f.mu.Unlock()
+	glog.V(1).Infof("loaded %d users from %s", len(users), f.watch.Path)
+	return nil
+}
+
+// Lookup returns the hash for the user, reloading the file first if it has changed
+func (f *File) Lookup(user string) (hash string, ok bool) {
+	if f.watch.Changed() {
+		if err := f.load(); err != nil {
+			glog.Errorf("Unable to reload htpasswd file: %s", err)
+		}
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	hash, ok = f.users[user]
+	return
+}
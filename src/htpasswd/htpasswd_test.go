@@ -0,0 +1,144 @@
+package htpasswd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestShaCrypt(t *testing.T) {
+	testcases := []struct {
+		password, setting, hash string
+	}{
+		{"Hello world!", "$5$saltstring", "$5$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5"},
+		{"Hello world!", "$6$saltstring", "$6$saltstring$svn8UoSVapNtMuq1ukKS4tPQd8iKwSMHWjl/O817G3uBnIFNjnQJuesI68u4OTLiBFdcbYEdFCoEOfaS35inz1"},
+		{"Hello world!", "$5$rounds=10000$saltstringsaltstring", "$5$rounds=10000$saltstringsaltst$3xv.VbSHBb41AL9AvLeujZkZRBAwqFMz2.opqey6IcA"},
+		{"Hello world!", "$6$rounds=1400$anotherlongsaltstring", "$6$rounds=1400$anotherlongsalts$5FGyu8c4BZDX4wJgs0Un26YOw2XibT5eTkHF1I1aP3QqStoJI9BHD2YPJYsAjEePVGUyBjdZxcNqMWlrrbIOC."},
+		{"the minimum number is still observed", "$6$rounds=10$roundstoolow", "$6$rounds=1000$roundstoolow$kUMsbe306n21p9R.FRkW3IGn.S9NPN0x50YhH1xhLsPuWGsUSklZt58jaTfF4ZEQpyUNGc0dqbpBYYBaHHrsX."},
+	}
+	for i, tc := range testcases {
+		h, err := ShaCrypt(tc.password, tc.setting)
+		if err != nil {
+			t.Errorf("testcase %d: unexpected error %s", i+1, err)
+		}
+		if h != tc.hash {
+			t.Errorf("testcase %d: got %s expected %s", i+1, h, tc.hash)
+		}
+	}
+}
+
+func TestMd5Crypt(t *testing.T) {
+	testcases := []struct {
+		password, setting, hash string
+	}{
+		{"myPassword", "$apr1$r31.....", "$apr1$r31.....$HqJZimcKQFAMYayBlzkrA/"},
+		{"password", "$1$saltsalt", "$1$saltsalt$qjXMvbEw8oaL.CzflDtaK/"},
+		{"", "$apr1$abcdefghijk$", "$apr1$abcdefgh$L.PT565ESX4Tp2bqNs7Ie."},
+	}
+	for i, tc := range testcases {
+		h, err := Md5Crypt(tc.password, tc.setting)
+		if err != nil {
+			t.Errorf("testcase %d: unexpected error %s", i+1, err)
+		}
+		if h != tc.hash {
+			t.Errorf("testcase %d: got %s expected %s", i+1, h, tc.hash)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	testcases := []struct {
+		hash, password string
+		ok             bool
+	}{
+		{"$2a$04$swjGWHdDYRXLdhka5XCENOCfmK4h2seta2sUSP/Q7tbFicKOBbBlS", "secret", true},
+		{"$2a$04$swjGWHdDYRXLdhka5XCENOCfmK4h2seta2sUSP/Q7tbFicKOBbBlS", "Secret", false},
+		{"$5$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5", "Hello world!", true},
+		{"$5$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5", "Hello world", false},
+		{"$6$saltstring$svn8UoSVapNtMuq1ukKS4tPQd8iKwSMHWjl/O817G3uBnIFNjnQJuesI68u4OTLiBFdcbYEdFCoEOfaS35inz1", "Hello world!", true},
+		{"{SHA}5en6G6MezRroT3XKqkdPOmY/BfQ=", "secret", true},
+		{"{SHA}5en6G6MezRroT3XKqkdPOmY/BfQ=", "secret2", false},
+		{"$apr1$r31.....$HqJZimcKQFAMYayBlzkrA/", "myPassword", true},
+		{"$apr1$r31.....$HqJZimcKQFAMYayBlzkrA/", "mypassword", false},
+		{"$1$saltsalt$qjXMvbEw8oaL.CzflDtaK/", "password", true},
+		// the hash is not a password
+		{"$apr1$r31.....$HqJZimcKQFAMYayBlzkrA/", "$apr1$r31.....$HqJZimcKQFAMYayBlzkrA/", false},
+		{"$9$salt$hash", "$9$salt$hash", false},
+		{"{SSHA}c2VjcmV0", "{SSHA}c2VjcmV0", false},
+		{"plain", "plain", false},
+		{"", "", false},
+		{"$5$", "", false},
+	}
+	for i, tc := range testcases {
+		if Verify(tc.hash, tc.password) != tc.ok {
+			t.Errorf("testcase %d: expected %v for %s", i+1, tc.ok, tc.hash)
+		}
+	}
+	inline := []struct {
+		value, password string
+		ok              bool
+	}{
+		{"plain", "plain", true},
+		{"plain", "plain2", false},
+		{"", "", true},
+		{"{SHA}5en6G6MezRroT3XKqkdPOmY/BfQ=", "secret", true},
+		{"{SHA}5en6G6MezRroT3XKqkdPOmY/BfQ=", "{SHA}5en6G6MezRroT3XKqkdPOmY/BfQ=", false},
+		{"$apr1$r31.....$HqJZimcKQFAMYayBlzkrA/", "$apr1$r31.....$HqJZimcKQFAMYayBlzkrA/", false},
+		{"$9$salt$hash", "$9$salt$hash", false},
+	}
+	for i, tc := range inline {
+		if VerifyInline(tc.value, tc.password) != tc.ok {
+			t.Errorf("inline testcase %d: expected %v for %s", i+1, tc.ok, tc.value)
+		}
+	}
+	if VerifyMissing("secret") {
+		t.Error("VerifyMissing should never succeed")
+	}
+}
+
+func TestFileReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "htpasswd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fn := filepath.Join(dir, "htpasswd")
+	if err := ioutil.WriteFile(fn, []byte("# comment\nuser1:{SHA}5en6G6MezRroT3XKqkdPOmY/BfQ=\n\nuser2:$5$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := Open(fn)
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	f.watch.Interval = time.Nanosecond
+	if h, ok := f.Lookup("user1"); !ok || h != "{SHA}5en6G6MezRroT3XKqkdPOmY/BfQ=" {
+		t.Errorf("Unexpected lookup result %v %s", ok, h)
+	}
+	if _, ok := f.Lookup("user3"); ok {
+		t.Error("user3 should not exist")
+	}
+	if err := ioutil.WriteFile(fn, []byte("user3:$apr1$r31.....$HqJZimcKQFAMYayBlzkrA/\nuser4:pass4\nuser5:$9$salt$hash\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if h, ok := f.Lookup("user3"); !ok || h != "$apr1$r31.....$HqJZimcKQFAMYayBlzkrA/" {
+		t.Errorf("Unexpected lookup result after reload %v %s", ok, h)
+	}
+	// plain text and unknown schemes are not accepted from the file
+	for _, user := range []string{"user4", "user5"} {
+		if h, ok := f.Lookup(user); ok {
+			t.Errorf("%s with unsupported hash %s should be ignored", user, h)
+		}
+	}
+	if _, ok := f.Lookup("user1"); ok {
+		t.Error("user1 should be removed after reload")
+	}
+	// broken file keeps the old content
+	if err := ioutil.WriteFile(fn, []byte("garbage\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := f.Lookup("user3"); !ok {
+		t.Error("user3 should survive failed reload")
+	}
+}
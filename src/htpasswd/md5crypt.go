@@ -0,0 +1,95 @@
+package htpasswd
+
+// MD5 based crypt(3) of FreeBSD ($1$) and its apache variant ($apr1$), the default of htpasswd.
+// http://svn.freebsd.org/base/head/lib/libcrypt/crypt-md5.c
+
+import (
+	"bytes"
+	"crypto/md5"
+	"errors"
+	"strings"
+)
+
+const (
+	md5CryptSaltMax = 8
+	md5CryptRounds  = 1000
+)
+
+var BadMd5CryptHash = errors.New("Malformed MD5-crypt hash")
+
+// byte order used to encode the final digest
+var md5CryptOrder = [][3]int{{0, 6, 12}, {1, 7, 13}, {2, 8, 14}, {3, 9, 15}, {4, 10, 5}}
+
+// Md5Crypt computes $1$ or $apr1$ hash of the password using the salt from the setting.
+// setting is a full hash or its prefix like "$apr1$salt"
+func Md5Crypt(password, setting string) (string, error) {
+	var magic string
+	switch {
+	case strings.HasPrefix(setting, "$1$"):
+		magic = "$1$"
+	case strings.HasPrefix(setting, "$apr1$"):
+		magic = "$apr1$"
+	default:
+		return "", BadMd5CryptHash
+	}
+	salt := setting[len(magic):]
+	if idx := strings.Index(salt, "$"); idx >= 0 {
+		salt = salt[:idx]
+	}
+	if len(salt) > md5CryptSaltMax {
+		salt = salt[:md5CryptSaltMax]
+	}
+	pw := []byte(password)
+	sb := []byte(salt)
+
+	h := md5.New()
+	h.Write(pw)
+	h.Write(sb)
+	h.Write(pw)
+	alt := h.Sum(nil)
+
+	h.Reset()
+	h.Write(pw)
+	h.Write([]byte(magic))
+	h.Write(sb)
+	h.Write(repeatDigest(alt, len(pw)))
+	for i := len(pw); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			h.Write([]byte{0})
+		} else {
+			h.Write(pw[:1])
+		}
+	}
+	c := h.Sum(nil)
+
+	for i := 0; i < md5CryptRounds; i++ {
+		h.Reset()
+		if i&1 != 0 {
+			h.Write(pw)
+		} else {
+			h.Write(c)
+		}
+		if i%3 != 0 {
+			h.Write(sb)
+		}
+		if i%7 != 0 {
+			h.Write(pw)
+		}
+		if i&1 != 0 {
+			h.Write(c)
+		} else {
+			h.Write(pw)
+		}
+		c = h.Sum(c[:0])
+	}
+
+	var out bytes.Buffer
+	out.WriteString(magic)
+	out.WriteString(salt)
+	out.WriteByte('$')
+	for _, o := range md5CryptOrder {
+		b64From24bit(&out, c[o[0]], c[o[1]], c[o[2]], 4)
+	}
+	b64From24bit(&out, 0, 0, c[11], 2)
+	return out.String(), nil
+}
@@ -0,0 +1,176 @@
+package htpasswd
+
+// SHA-256/SHA-512 based crypt(3) as specified in
+// http://www.akkadia.org/drepper/SHA-crypt.txt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"hash"
+	"strconv"
+	"strings"
+)
+
+const (
+	shaCryptSaltMax       = 16
+	shaCryptRoundsDefault = 5000
+	shaCryptRoundsMin     = 1000
+	shaCryptRoundsMax     = 999999999
+)
+
+var BadShaCryptHash = errors.New("Malformed SHA-crypt hash")
+
+const cryptAlphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// byte order used to encode the final digests
+var sha256CryptOrder = [][3]int{
+	{0, 10, 20}, {21, 1, 11}, {12, 22, 2}, {3, 13, 23}, {24, 4, 14},
+	{15, 25, 5}, {6, 16, 26}, {27, 7, 17}, {18, 28, 8}, {9, 19, 29},
+}
+
+var sha512CryptOrder = [][3]int{
+	{0, 21, 42}, {22, 43, 1}, {44, 2, 23}, {3, 24, 45}, {25, 46, 4},
+	{47, 5, 26}, {6, 27, 48}, {28, 49, 7}, {50, 8, 29}, {9, 30, 51},
+	{31, 52, 10}, {53, 11, 32}, {12, 33, 54}, {34, 55, 13}, {56, 14, 35},
+	{15, 36, 57}, {37, 58, 16}, {59, 17, 38}, {18, 39, 60}, {40, 61, 19},
+	{62, 20, 41},
+}
+
+func b64From24bit(buf *bytes.Buffer, b2, b1, b0 byte, n int) {
+	w := uint(b2)<<16 | uint(b1)<<8 | uint(b0)
+	for ; n > 0; n-- {
+		buf.WriteByte(cryptAlphabet[w&0x3f])
+		w >>= 6
+	}
+}
+
+// repeat digest d to fill n bytes
+func repeatDigest(d []byte, n int) []byte {
+	out := make([]byte, 0, n)
+	for len(out)+len(d) <= n {
+		out = append(out, d...)
+	}
+	return append(out, d[:n-len(out)]...)
+}
+
+// ShaCrypt computes $5$ or $6$ hash of the password using algorithm, salt and rounds from the setting.
+// setting is a full hash or its prefix like "$6$rounds=10000$salt"
+func ShaCrypt(password, setting string) (string, error) {
+	var newHash func() hash.Hash
+	var order [][3]int
+	var prefix string
+	switch {
+	case strings.HasPrefix(setting, "$5$"):
+		newHash, order, prefix = sha256.New, sha256CryptOrder, "$5$"
+	case strings.HasPrefix(setting, "$6$"):
+		newHash, order, prefix = sha512.New, sha512CryptOrder, "$6$"
+	default:
+		return "", BadShaCryptHash
+	}
+	rest := setting[len(prefix):]
+	rounds := shaCryptRoundsDefault
+	explicitRounds := false
+	if strings.HasPrefix(rest, "rounds=") {
+		idx := strings.Index(rest, "$")
+		if idx < 0 {
+			return "", BadShaCryptHash
+		}
+		r, err := strconv.ParseUint(rest[len("rounds="):idx], 10, 64)
+		if err != nil {
+			return "", BadShaCryptHash
+		}
+		switch {
+		case r < shaCryptRoundsMin:
+			rounds = shaCryptRoundsMin
+		case r > shaCryptRoundsMax:
+			rounds = shaCryptRoundsMax
+		default:
+			rounds = int(r)
+		}
+		explicitRounds = true
+		rest = rest[idx+1:]
+	}
+	salt := rest
+	if idx := strings.Index(salt, "$"); idx >= 0 {
+		salt = salt[:idx]
+	}
+	if len(salt) > shaCryptSaltMax {
+		salt = salt[:shaCryptSaltMax]
+	}
+	pw := []byte(password)
+	sb := []byte(salt)
+
+	h := newHash()
+	h.Write(pw)
+	h.Write(sb)
+	h.Write(pw)
+	b := h.Sum(nil)
+
+	h.Reset()
+	h.Write(pw)
+	h.Write(sb)
+	h.Write(repeatDigest(b, len(pw)))
+	for i := len(pw); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			h.Write(b)
+		} else {
+			h.Write(pw)
+		}
+	}
+	a := h.Sum(nil)
+
+	h.Reset()
+	for i := 0; i < len(pw); i++ {
+		h.Write(pw)
+	}
+	p := repeatDigest(h.Sum(nil), len(pw))
+
+	h.Reset()
+	for i := 0; i < 16+int(a[0]); i++ {
+		h.Write(sb)
+	}
+	s := repeatDigest(h.Sum(nil), len(sb))
+
+	c := a
+	for i := 0; i < rounds; i++ {
+		h.Reset()
+		if i&1 != 0 {
+			h.Write(p)
+		} else {
+			h.Write(c)
+		}
+		if i%3 != 0 {
+			h.Write(s)
+		}
+		if i%7 != 0 {
+			h.Write(p)
+		}
+		if i&1 != 0 {
+			h.Write(c)
+		} else {
+			h.Write(p)
+		}
+		c = h.Sum(c[:0])
+	}
+
+	var out bytes.Buffer
+	out.WriteString(prefix)
+	if explicitRounds {
+		out.WriteString("rounds=")
+		out.WriteString(strconv.Itoa(rounds))
+		out.WriteByte('$')
+	}
+	out.WriteString(salt)
+	out.WriteByte('$')
+	for _, o := range order {
+		b64From24bit(&out, c[o[0]], c[o[1]], c[o[2]], 4)
+	}
+	if len(c) == sha256.Size {
+		b64From24bit(&out, 0, c[31], c[30], 3)
+	} else {
+		b64From24bit(&out, 0, 0, c[63], 2)
+	}
+	return out.String(), nil
+}
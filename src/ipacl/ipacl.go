@@ -0,0 +1,364 @@
+// Package ipacl implements client ip allow/deny lists.
+// Prefixes are kept in a binary prefix tree, so lookups cost at most 32 (128 for IPv6)
+// steps regardless of the list size. The most specific matching prefix decides.
+package ipacl
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/apesternikov/backplane/src/filewatch"
+
+	"github.com/golang/glog"
+)
+
+// Rule is a single allow or deny prefix
+type Rule struct {
+	Net      *net.IPNet
+	Allow    bool
+	Source   string // "inline" or the file name the rule was loaded from
+	rejected uint64
+}
+
+// Rejected returns the number of clients rejected by this rule
+func (r *Rule) Rejected() uint64 {
+	return atomic.LoadUint64(&r.rejected)
+}
+
+func (r *Rule) String() string {
+	action := "deny"
+	if r.Allow {
+		action = "allow"
+	}
+	return action + " " + r.Net.String()
+}
+
+func (r *Rule) key() string {
+	return r.String()
+}
+
+type node struct {
+	child [2]*node
+	rule  *Rule
+}
+
+// tree is a binary prefix tree, IPv4 and IPv6 addresses are kept in separate roots
+type tree struct {
+	v4, v6 node
+}
+
+func (t *tree) root(ip net.IP) (*node, net.IP) {
+	if ip4 := ip.To4(); ip4 != nil {
+		return &t.v4, ip4
+	}
+	return &t.v6, ip.To16()
+}
+
+func bit(ip net.IP, i int) int {
+	return int(ip[i/8]>>(7-uint(i%8))) & 1
+}
+
+func (t *tree) insert(r *Rule) {
+	n, ip := t.root(r.Net.IP)
+	ones, _ := r.Net.Mask.Size()
+	for i := 0; i < ones; i++ {
+		b := bit(ip, i)
+		if n.child[b] == nil {
+			n.child[b] = &node{}
+		}
+		n = n.child[b]
+	}
+	// deny wins over allow for the same prefix
+	if n.rule == nil || n.rule.Allow {
+		n.rule = r
+	}
+}
+
+// lookup returns the most specific rule matching ip or nil
+func (t *tree) lookup(ip net.IP) *Rule {
+	n, ip := t.root(ip)
+	if ip == nil {
+		return nil
+	}
+	var found *Rule
+	for i := 0; n != nil; i++ {
+		if n.rule != nil {
+			found = n.rule
+		}
+		if i == len(ip)*8 {
+			break
+		}
+		n = n.child[bit(ip, i)]
+	}
+	return found
+}
+
+// ParsePrefix parses CIDR notation or a single address
+func ParsePrefix(s string) (*net.IPNet, error) {
+	if strings.Contains(s, "/") {
+		_, n, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, err
+		}
+		ones, bits := n.Mask.Size()
+		if ip4 := n.IP.To4(); ip4 != nil {
+			// IPv4-mapped IPv6 prefixes like ::ffff:10.0.0.0/104 are kept in the IPv4 tree
+			if bits == 128 {
+				ones -= 96
+			}
+			n = &net.IPNet{IP: ip4, Mask: net.CIDRMask(ones, 32)}
+		}
+		return n, nil
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid address %q", s)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+}
+
+// ParseList reads one prefix per line. Empty lines and text after # are ignored.
+func ParseList(data []byte) ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineno := 1; scanner.Scan(); lineno++ {
+		line := scanner.Text()
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		n, err := ParsePrefix(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", lineno, err)
+		}
+		nets = append(nets, n)
+	}
+	return nets, scanner.Err()
+}
+
+type listFile struct {
+	watch *filewatch.File
+	allow bool
+	nets  []*net.IPNet // last successfully loaded content
+}
+
+func (f *listFile) load() error {
+	data, err := ioutil.ReadFile(f.watch.Path)
+	if err != nil {
+		return err
+	}
+	nets, err := ParseList(data)
+	if err != nil {
+		return fmt.Errorf("%s: %s", f.watch.Path, err)
+	}
+	f.nets = nets
+	glog.V(1).Infof("loaded %d prefixes from %s", len(nets), f.watch.Path)
+	return nil
+}
+
+type ruleSet struct {
+	tree     tree
+	rules    []*Rule
+	hasAllow bool
+}
+
+// ACL decides whether a client address is allowed. If no rule matches, the address is
+// denied when the list has any allow rules and allowed otherwise.
+// Files are reloaded when modified, a file that fails to load keeps its previous content.
+type ACL struct {
+	inline          []*Rule
+	files           []*listFile
+	reloadMu        sync.Mutex
+	mu              sync.RWMutex
+	rules           *ruleSet
+	defaultRejected uint64
+}
+
+// New creates an ACL from inline prefixes and list files
+func New(allow, deny, allowFiles, denyFiles []string) (*ACL, error) {
+	a := &ACL{}
+	for _, list := range []struct {
+		prefixes []string
+		allow    bool
+	}{{allow, true}, {deny, false}} {
+		for _, s := range list.prefixes {
+			n, err := ParsePrefix(s)
+			if err != nil {
+				return nil, err
+			}
+			a.inline = append(a.inline, &Rule{Net: n, Allow: list.allow, Source: "inline"})
+		}
+	}
+	for _, list := range []struct {
+		files []string
+		allow bool
+	}{{allowFiles, true}, {denyFiles, false}} {
+		for _, fn := range list.files {
+			f := &listFile{watch: filewatch.New(fn), allow: list.allow}
+			f.watch.Changed()
+			if err := f.load(); err != nil {
+				return nil, err
+			}
+			a.files = append(a.files, f)
+		}
+	}
+	a.rebuild()
+	return a, nil
+}
+
+func (a *ACL) rebuild() {
+	rs := &ruleSet{}
+	old := make(map[string]*Rule)
+	if a.rules != nil {
+		for _, r := range a.rules.rules {
+			old[r.key()] = r
+		}
+	}
+	add := func(r *Rule) {
+		// keep counters across reloads
+		if o, ok := old[r.key()]; ok {
+			r.rejected = o.Rejected()
+		}
+		rs.rules = append(rs.rules, r)
+		rs.tree.insert(r)
+		if r.Allow {
+			rs.hasAllow = true
+		}
+	}
+	for _, r := range a.inline {
+		add(&Rule{Net: r.Net, Allow: r.Allow, Source: r.Source})
+	}
+	for _, f := range a.files {
+		for _, n := range f.nets {
+			add(&Rule{Net: n, Allow: f.allow, Source: f.watch.Path})
+		}
+	}
+	a.mu.Lock()
+	a.rules = rs
+	a.mu.Unlock()
+}
+
+func (a *ACL) reloadIfChanged() {
+	changed := false
+	for _, f := range a.files {
+		if f.watch.Changed() {
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+	a.reloadMu.Lock()
+	defer a.reloadMu.Unlock()
+	for _, f := range a.files {
+		if err := f.load(); err != nil {
+			glog.Errorf("Unable to reload ip list: %s", err)
+		}
+	}
+	a.rebuild()
+}
+
+// Check returns whether ip is allowed and the rule that decided it, nil if no rule matched.
+// nil ip is never allowed. Rejections are counted.
+func (a *ACL) Check(ip net.IP) (bool, *Rule) {
+	if ip == nil {
+		atomic.AddUint64(&a.defaultRejected, 1)
+		return false, nil
+	}
+	a.reloadIfChanged()
+	a.mu.RLock()
+	rs := a.rules
+	a.mu.RUnlock()
+	r := rs.tree.lookup(ip)
+	switch {
+	case r == nil && rs.hasAllow:
+		atomic.AddUint64(&a.defaultRejected, 1)
+		return false, nil
+	case r == nil:
+		return true, nil
+	case !r.Allow:
+		atomic.AddUint64(&r.rejected, 1)
+		return false, r
+	}
+	return true, r
+}
+
+// Allowed checks the address given as a string. Addresses that can not be parsed are denied
+// even by deny only lists.
+func (a *ACL) Allowed(addr string) bool {
+	ip := net.ParseIP(addr)
+	allowed, _ := a.Check(ip)
+	return allowed
+}
+
+// Rejected returns the total number of rejections
+func (a *ACL) Rejected() uint64 {
+	total := atomic.LoadUint64(&a.defaultRejected)
+	for _, r := range a.Rules() {
+		total += r.Rejected()
+	}
+	return total
+}
+
+// DefaultRejected returns the number of rejections by the default policy
+func (a *ACL) DefaultRejected() uint64 {
+	return atomic.LoadUint64(&a.defaultRejected)
+}
+
+// Rules returns current rules
+func (a *ACL) Rules() []*Rule {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.rules.rules
+}
+
+// TopRejecting returns up to n rules with the most rejections
+func (a *ACL) TopRejecting(n int) []*Rule {
+	var top []*Rule
+	for _, r := range a.Rules() {
+		if r.Rejected() > 0 {
+			top = append(top, r)
+		}
+	}
+	sort.Slice(top, func(i, j int) bool { return top[i].Rejected() > top[j].Rejected() })
+	if len(top) > n {
+		top = top[:n]
+	}
+	return top
+}
+
+// Set is a list of prefixes without allow/deny semantics or counters, like trusted networks
+type Set struct {
+	tree tree
+}
+
+// NewSet parses CIDR prefixes or single addresses
+func NewSet(prefixes []string) (*Set, error) {
+	s := &Set{}
+	for _, p := range prefixes {
+		n, err := ParsePrefix(p)
+		if err != nil {
+			return nil, err
+		}
+		s.tree.insert(&Rule{Net: n, Allow: true})
+	}
+	return s, nil
+}
+
+// Contains reports whether ip belongs to any prefix of the set. nil *Set contains nothing.
+func (s *Set) Contains(ip net.IP) bool {
+	return s != nil && s.tree.lookup(ip) != nil
+}
@@ -0,0 +1,140 @@
+package ipacl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestMostSpecificMatch(t *testing.T) {
+	a, err := New(
+		[]string{"10.0.0.0/8", "10.1.2.3", "2001:db8::/32", "::ffff:192.168.0.0/112"},
+		[]string{"10.1.0.0/16", "2001:db8:1::/48", "192.168.1.0/24"},
+		nil, nil)
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	testcases := []struct {
+		ip      string
+		allowed bool
+		rule    string
+	}{
+		{"10.0.0.1", true, "allow 10.0.0.0/8"},
+		{"10.1.0.1", false, "deny 10.1.0.0/16"},
+		{"10.1.2.3", true, "allow 10.1.2.3/32"},
+		{"::ffff:10.1.2.3", true, "allow 10.1.2.3/32"},
+		{"192.168.0.1", true, "allow 192.168.0.0/16"},
+		{"192.168.1.1", false, "deny 192.168.1.0/24"},
+		{"11.0.0.1", false, ""},
+		{"2001:db8::1", true, "allow 2001:db8::/32"},
+		{"2001:db8:1::1", false, "deny 2001:db8:1::/48"},
+		{"2001:db9::1", false, ""},
+	}
+	for _, tc := range testcases {
+		allowed, rule := a.Check(net.ParseIP(tc.ip))
+		if allowed != tc.allowed {
+			t.Errorf("%s: expected allowed=%v", tc.ip, tc.allowed)
+		}
+		if (rule == nil && tc.rule != "") || (rule != nil && rule.String() != tc.rule) {
+			t.Errorf("%s: expected rule %q got %v", tc.ip, tc.rule, rule)
+		}
+	}
+	if a.Allowed("garbage") {
+		t.Error("unparsable address should be denied")
+	}
+	if a.DefaultRejected() != 3 || a.Rejected() != 6 {
+		t.Errorf("unexpected rejection counters %d %d", a.DefaultRejected(), a.Rejected())
+	}
+	top := a.TopRejecting(1)
+	if len(top) != 1 || top[0].Rejected() != 1 {
+		t.Errorf("unexpected top rejecting rules %v", top)
+	}
+}
+
+func TestDenyOnly(t *testing.T) {
+	a, err := New(nil, []string{"0.0.0.0/0", "1.2.3.4"}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Allowed("8.8.8.8") || !a.Allowed("::1") {
+		t.Error("deny only list should allow everything not denied")
+	}
+	for _, bad := range []string{"garbage", "", "1.2.3.4:80"} {
+		if a.Allowed(bad) {
+			t.Errorf("unparsable address %q should be denied", bad)
+		}
+	}
+	// the same prefix in both lists is denied
+	a, err = New([]string{"1.2.3.0/24"}, []string{"1.2.3.0/24"}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Allowed("1.2.3.4") {
+		t.Error("deny should win over allow for the same prefix")
+	}
+	for _, bad := range []string{"1.2.3.4/33", "1.2.3", "hello"} {
+		if _, err := New([]string{bad}, nil, nil, nil); err == nil {
+			t.Errorf("expected error for %s", bad)
+		}
+	}
+}
+
+func TestFileReload(t *testing.T) {
+	f, err := ioutil.TempFile("", "ipacl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("# bad guys\n1.2.3.0/24 # scanner\n\n5.6.7.8\n")
+	f.Close()
+	a, err := New(nil, nil, nil, []string{f.Name()})
+	if err != nil {
+		t.Fatal("Unexpected error: ", err)
+	}
+	a.files[0].watch.Interval = time.Nanosecond
+	if a.Allowed("1.2.3.4") || a.Allowed("5.6.7.8") || !a.Allowed("5.6.7.9") {
+		t.Error("unexpected check result")
+	}
+	later := time.Now().Add(time.Second)
+	if err := ioutil.WriteFile(f.Name(), []byte("5.6.7.0/24\n1.2.3.0/24\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	os.Chtimes(f.Name(), later, later)
+	if a.Allowed("5.6.7.9") {
+		t.Error("expected reloaded list to deny 5.6.7.9")
+	}
+	// counters survive reload for the same rule
+	for _, r := range a.Rules() {
+		if r.Net.String() == "1.2.3.0/24" && r.Rejected() != 1 {
+			t.Errorf("expected counter to be kept, got %d", r.Rejected())
+		}
+	}
+	// broken file keeps the previous list
+	if err := ioutil.WriteFile(f.Name(), []byte("garbage\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	later = later.Add(time.Second)
+	os.Chtimes(f.Name(), later, later)
+	if a.Allowed("5.6.7.9") {
+		t.Error("expected previous list to survive failed reload")
+	}
+}
+
+func BenchmarkLookup(b *testing.B) {
+	var deny []string
+	for i := 0; i < 50000; i++ {
+		deny = append(deny, fmt.Sprintf("%d.%d.%d.0/24", 1+i>>16, (i>>8)&0xff, i&0xff))
+	}
+	a, err := New(nil, deny, nil, nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	ip := net.ParseIP("1.100.7.9")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Check(ip)
+	}
+}
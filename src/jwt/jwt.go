@@ -0,0 +1,319 @@
+// Package jwt validates JSON Web Tokens (RFC 7519) signed with JWS compact serialization.
+// Supported algorithms are HS256/384/512, RS256/384/512, PS256/384/512, ES256/384/512 and EdDSA.
+package jwt
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+var (
+	MalformedToken   = errors.New("Malformed token")
+	UnsupportedAlg   = errors.New("Unsupported signing algorithm")
+	NoMatchingKey    = errors.New("No key matching the token")
+	InvalidSignature = errors.New("Invalid token signature")
+	TokenExpired     = errors.New("Token is expired")
+	MissingExp       = errors.New("Token has no expiration time")
+	TokenNotYetValid = errors.New("Token is not valid yet")
+	InvalidIssuer    = errors.New("Token issuer is not accepted")
+	InvalidAudience  = errors.New("Token audience is not accepted")
+)
+
+type Header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	Typ string `json:"typ"`
+}
+
+// Claims is a decoded token payload. Numbers are kept as json.Number
+type Claims map[string]interface{}
+
+// Lookup finds the claim by name. Dots in the name address nested objects, like "realm_access.roles"
+func (c Claims) Lookup(name string) (interface{}, bool) {
+	if v, ok := c[name]; ok {
+		return v, true
+	}
+	var cur interface{} = map[string]interface{}(c)
+	for _, part := range strings.Split(name, ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if cur, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+// String returns the claim formatted for passing in a http header:
+// strings as is, arrays as comma separated values, everything else as json
+func (c Claims) String(name string) (string, bool) {
+	v, ok := c.Lookup(name)
+	if !ok {
+		return "", false
+	}
+	return claimString(v), true
+}
+
+func claimString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = claimString(item)
+		}
+		return strings.Join(parts, ",")
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+// Matches checks that the claim is equal to the value, or contains it if the claim is an array
+func (c Claims) Matches(name, value string) bool {
+	v, ok := c.Lookup(name)
+	if !ok {
+		return false
+	}
+	if arr, ok := v.([]interface{}); ok {
+		for _, item := range arr {
+			if claimString(item) == value {
+				return true
+			}
+		}
+		return false
+	}
+	return claimString(v) == value
+}
+
+// time claim (exp, nbf, iat). ok is false if the claim is missing
+func (c Claims) time(name string) (t time.Time, ok bool, err error) {
+	v, ok := c[name]
+	if !ok {
+		return
+	}
+	n, isnum := v.(json.Number)
+	if !isnum {
+		return t, true, fmt.Errorf("claim %s is not a number", name)
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return t, true, fmt.Errorf("claim %s: %s", name, err)
+	}
+	return time.Unix(int64(f), 0), true, nil
+}
+
+// Validator checks token signatures and standard claims
+type Validator struct {
+	Keys       []KeySource
+	Issuers    []string // accepted iss, any if empty
+	Audiences  []string // accepted aud, any if empty
+	Leeway     time.Duration
+	RequireExp bool             // reject tokens without exp, they would never expire
+	Now        func() time.Time // for tests, time.Now if nil
+}
+
+// Validate verifies the token and returns its claims
+func (v *Validator) Validate(token string) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, MalformedToken
+	}
+	var hdr Header
+	if err := decodeSegment(parts[0], &hdr); err != nil {
+		return nil, err
+	}
+	alg, ok := algorithms[hdr.Alg]
+	if !ok {
+		return nil, UnsupportedAlg
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, MalformedToken
+	}
+	signed := []byte(parts[0] + "." + parts[1])
+	if err := v.verify(&hdr, alg, signed, sig); err != nil {
+		return nil, err
+	}
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+	if err := v.checkClaims(claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (v *Validator) verify(hdr *Header, alg *algorithm, signed, sig []byte) error {
+	for _, refresh := range []bool{false, true} {
+		found := false
+		for _, src := range v.Keys {
+			for _, key := range src.Keys(refresh) {
+				if !key.usableFor(hdr, alg) {
+					continue
+				}
+				found = true
+				if alg.verify(key, signed, sig) {
+					return nil
+				}
+			}
+		}
+		if found {
+			return InvalidSignature
+		}
+	}
+	return NoMatchingKey
+}
+
+func (v *Validator) checkClaims(c Claims) error {
+	now := time.Now()
+	if v.Now != nil {
+		now = v.Now()
+	}
+	exp, ok, err := c.time("exp")
+	if err != nil {
+		return err
+	}
+	if !ok && v.RequireExp {
+		return MissingExp
+	}
+	if ok && !now.Before(exp.Add(v.Leeway)) {
+		return TokenExpired
+	}
+	nbf, ok, err := c.time("nbf")
+	if err != nil {
+		return err
+	}
+	if ok && now.Add(v.Leeway).Before(nbf) {
+		return TokenNotYetValid
+	}
+	if len(v.Issuers) > 0 {
+		iss, _ := c["iss"].(string)
+		if !contains(v.Issuers, iss) {
+			return InvalidIssuer
+		}
+	}
+	if len(v.Audiences) > 0 {
+		accepted := false
+		switch aud := c["aud"].(type) {
+		case string:
+			accepted = contains(v.Audiences, aud)
+		case []interface{}:
+			for _, a := range aud {
+				if s, ok := a.(string); ok && contains(v.Audiences, s) {
+					accepted = true
+					break
+				}
+			}
+		}
+		if !accepted {
+			return InvalidAudience
+		}
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeSegment(seg string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return MalformedToken
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(v); err != nil {
+		return MalformedToken
+	}
+	return nil
+}
+
+type keyType int
+
+const (
+	keyHMAC keyType = iota
+	keyRSA
+	keyEC
+	keyEd25519
+)
+
+type algorithm struct {
+	keyType keyType
+	hash    crypto.Hash
+	pss     bool
+	verify  func(key *Key, signed, sig []byte) bool
+}
+
+var algorithms = map[string]*algorithm{}
+
+func init() {
+	for name, h := range map[string]crypto.Hash{"256": crypto.SHA256, "384": crypto.SHA384, "512": crypto.SHA512} {
+		algorithms["HS"+name] = &algorithm{keyType: keyHMAC, hash: h}
+		algorithms["RS"+name] = &algorithm{keyType: keyRSA, hash: h}
+		algorithms["PS"+name] = &algorithm{keyType: keyRSA, hash: h, pss: true}
+		algorithms["ES"+name] = &algorithm{keyType: keyEC, hash: h}
+	}
+	algorithms["EdDSA"] = &algorithm{keyType: keyEd25519}
+	for _, alg := range algorithms {
+		alg.verify = alg.doVerify
+	}
+}
+
+func (a *algorithm) digest(data []byte) []byte {
+	h := a.hash.New()
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+func (a *algorithm) doVerify(key *Key, signed, sig []byte) bool {
+	switch a.keyType {
+	case keyHMAC:
+		mac := hmac.New(a.hash.New, key.secret)
+		mac.Write(signed)
+		return hmac.Equal(mac.Sum(nil), sig)
+	case keyRSA:
+		pub := key.public.(*rsa.PublicKey)
+		if a.pss {
+			return rsa.VerifyPSS(pub, a.hash, a.digest(signed), sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}) == nil
+		}
+		return rsa.VerifyPKCS1v15(pub, a.hash, a.digest(signed), sig) == nil
+	case keyEC:
+		pub := key.public.(*ecdsa.PublicKey)
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return false
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		return ecdsa.Verify(pub, a.digest(signed), r, s)
+	case keyEd25519:
+		return ed25519.Verify(key.public.(ed25519.PublicKey), signed, sig)
+	}
+	return false
+}
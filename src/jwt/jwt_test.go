@@ -0,0 +1,381 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func encode(t *testing.T, hdr map[string]interface{}, claims map[string]interface{}) string {
+	h, err := json.Marshal(hdr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b64(h) + "." + b64(c)
+}
+
+// sign creates a token signed with priv, which is []byte for HMAC or a crypto.Signer
+func sign(t *testing.T, alg, kid string, priv interface{}, claims map[string]interface{}) string {
+	hdr := map[string]interface{}{"alg": alg, "typ": "JWT"}
+	if kid != "" {
+		hdr["kid"] = kid
+	}
+	signed := encode(t, hdr, claims)
+	a := algorithms[alg]
+	var sig []byte
+	var err error
+	switch priv := priv.(type) {
+	case []byte:
+		mac := hmac.New(a.hash.New, priv)
+		mac.Write([]byte(signed))
+		sig = mac.Sum(nil)
+	case *rsa.PrivateKey:
+		if a.pss {
+			sig, err = rsa.SignPSS(rand.Reader, priv, a.hash, a.digest([]byte(signed)), &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		} else {
+			sig, err = rsa.SignPKCS1v15(rand.Reader, priv, a.hash, a.digest([]byte(signed)))
+		}
+	case *ecdsa.PrivateKey:
+		r, s, e := ecdsa.Sign(rand.Reader, priv, a.digest([]byte(signed)))
+		size := (priv.Curve.Params().BitSize + 7) / 8
+		sig = make([]byte, 2*size)
+		r.FillBytes(sig[:size])
+		s.FillBytes(sig[size:])
+		err = e
+	case ed25519.PrivateKey:
+		sig = ed25519.Sign(priv, []byte(signed))
+	default:
+		t.Fatalf("unexpected key type %T", priv)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + b64(sig)
+}
+
+func pemPublic(t *testing.T, pub crypto.PublicKey) []byte {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func TestAlgorithms(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ec256, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ec384, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	ec521, _ := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	edPub, edPriv, _ := ed25519.GenerateKey(rand.Reader)
+	secret := []byte("secret")
+
+	var keys StaticKeys
+	for _, pub := range []crypto.PublicKey{&rsaKey.PublicKey, &ec256.PublicKey, &ec384.PublicKey, &ec521.PublicKey, edPub} {
+		k, err := ParsePEM(pemPublic(t, pub))
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, k...)
+	}
+	keys = append(keys, NewHMACKey(secret))
+	v := &Validator{Keys: []KeySource{keys}}
+
+	claims := map[string]interface{}{"sub": "user"}
+	testcases := []struct {
+		alg  string
+		priv interface{}
+	}{
+		{"HS256", secret}, {"HS384", secret}, {"HS512", secret},
+		{"RS256", rsaKey}, {"RS384", rsaKey}, {"RS512", rsaKey},
+		{"PS256", rsaKey}, {"PS384", rsaKey}, {"PS512", rsaKey},
+		{"ES256", ec256}, {"ES384", ec384}, {"ES512", ec521},
+		{"EdDSA", edPriv},
+	}
+	for _, tc := range testcases {
+		token := sign(t, tc.alg, "", tc.priv, claims)
+		c, err := v.Validate(token)
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", tc.alg, err)
+			continue
+		}
+		if c["sub"] != "user" {
+			t.Errorf("%s: unexpected claims %v", tc.alg, c)
+		}
+		// flip a bit in the signature
+		tampered := []byte(token)
+		tampered[len(tampered)-2] ^= 1
+		if _, err := v.Validate(string(tampered)); err == nil {
+			t.Errorf("%s: tampered token accepted", tc.alg)
+		}
+	}
+
+	// wrong HMAC secret and unsigned tokens
+	if _, err := v.Validate(sign(t, "HS256", "", []byte("other"), claims)); err != InvalidSignature {
+		t.Errorf("expected InvalidSignature, got %v", err)
+	}
+	unsigned := encode(t, map[string]interface{}{"alg": "none"}, claims) + "."
+	if _, err := v.Validate(unsigned); err != UnsupportedAlg {
+		t.Errorf("expected UnsupportedAlg, got %v", err)
+	}
+	for _, bad := range []string{"", "a.b", "a.b.c", "a.b.c.d"} {
+		if _, err := v.Validate(bad); err == nil {
+			t.Errorf("malformed token %q accepted", bad)
+		}
+	}
+}
+
+func TestClaims(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Unix(1500000000, 0)
+	v := &Validator{
+		Keys:      []KeySource{StaticKeys{NewHMACKey(secret)}},
+		Issuers:   []string{"https://issuer"},
+		Audiences: []string{"api"},
+		Leeway:    10 * time.Second,
+		Now:       func() time.Time { return now },
+	}
+	testcases := []struct {
+		claims map[string]interface{}
+		err    error
+	}{
+		{map[string]interface{}{"iss": "https://issuer", "aud": "api", "exp": now.Unix() + 60}, nil},
+		{map[string]interface{}{"iss": "https://issuer", "aud": []string{"other", "api"}}, nil},
+		{map[string]interface{}{"iss": "https://issuer", "aud": "api", "exp": now.Unix() - 5}, nil},
+		{map[string]interface{}{"iss": "https://issuer", "aud": "api", "exp": now.Unix() - 60}, TokenExpired},
+		{map[string]interface{}{"iss": "https://issuer", "aud": "api", "nbf": now.Unix() + 5}, nil},
+		{map[string]interface{}{"iss": "https://issuer", "aud": "api", "nbf": now.Unix() + 60}, TokenNotYetValid},
+		{map[string]interface{}{"iss": "https://other", "aud": "api"}, InvalidIssuer},
+		{map[string]interface{}{"aud": "api"}, InvalidIssuer},
+		{map[string]interface{}{"iss": "https://issuer", "aud": []string{"other"}}, InvalidAudience},
+		{map[string]interface{}{"iss": "https://issuer"}, InvalidAudience},
+	}
+	for i, tc := range testcases {
+		_, err := v.Validate(sign(t, "HS256", "", secret, tc.claims))
+		if err != tc.err {
+			t.Errorf("testcase %d: expected %v got %v", i+1, tc.err, err)
+		}
+	}
+	if _, err := v.Validate(sign(t, "HS256", "", secret, map[string]interface{}{"iss": "https://issuer", "aud": "api", "exp": "tomorrow"})); err == nil {
+		t.Error("non numeric exp accepted")
+	}
+	v.RequireExp = true
+	if _, err := v.Validate(sign(t, "HS256", "", secret, map[string]interface{}{"iss": "https://issuer", "aud": "api"})); err != MissingExp {
+		t.Error("expected MissingExp got ", err)
+	}
+	if _, err := v.Validate(sign(t, "HS256", "", secret, map[string]interface{}{"iss": "https://issuer", "aud": "api", "exp": now.Unix() + 60})); err != nil {
+		t.Error("unexpected error ", err)
+	}
+
+	c := Claims{}
+	if err := decodeSegment(b64([]byte(`{"sub":"u","n":42,"roles":["a","b"],"realm_access":{"roles":["admin"]},"obj":{"x":1}}`)), &c); err != nil {
+		t.Fatal(err)
+	}
+	matches := []struct {
+		name, value string
+		ok          bool
+	}{
+		{"sub", "u", true},
+		{"sub", "v", false},
+		{"n", "42", true},
+		{"roles", "b", true},
+		{"roles", "c", false},
+		{"realm_access.roles", "admin", true},
+		{"realm_access.missing", "admin", false},
+		{"missing", "", false},
+	}
+	for _, m := range matches {
+		if c.Matches(m.name, m.value) != m.ok {
+			t.Errorf("Matches(%s, %s) expected %v", m.name, m.value, m.ok)
+		}
+	}
+	for name, expected := range map[string]string{"sub": "u", "n": "42", "roles": "a,b", "obj": `{"x":1}`} {
+		if s, _ := c.String(name); s != expected {
+			t.Errorf("String(%s): expected %s got %s", name, expected, s)
+		}
+	}
+}
+
+func jwks(t *testing.T, keys ...map[string]interface{}) []byte {
+	b, err := json.Marshal(map[string]interface{}{"keys": keys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func rsaJwk(kid string, pub *rsa.PublicKey) map[string]interface{} {
+	return map[string]interface{}{"kty": "RSA", "kid": kid, "n": b64(pub.N.Bytes()), "e": b64([]byte{1, 0, 1}), "use": "sig"}
+}
+
+func TestJwks(t *testing.T) {
+	rsa1, _ := rsa.GenerateKey(rand.Reader, 2048)
+	rsa2, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ec, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	edPub, edPriv, _ := ed25519.GenerateKey(rand.Reader)
+	keys, err := ParseJWKS(jwks(t,
+		rsaJwk("rsa1", &rsa1.PublicKey),
+		map[string]interface{}{"kty": "EC", "kid": "ec", "crv": "P-256", "x": b64(ec.X.Bytes()), "y": b64(ec.Y.Bytes())},
+		map[string]interface{}{"kty": "OKP", "kid": "ed", "crv": "Ed25519", "x": b64(edPub)},
+		map[string]interface{}{"kty": "oct", "kid": "hmac", "k": b64([]byte("secret")), "alg": "HS256"},
+		map[string]interface{}{"kty": "RSA", "kid": "enc", "use": "enc", "n": "AQAB", "e": "AQAB"},
+		map[string]interface{}{"kty": "unknown"},
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 4 {
+		t.Fatalf("expected 4 keys got %d", len(keys))
+	}
+	v := &Validator{Keys: []KeySource{StaticKeys(keys)}}
+	claims := map[string]interface{}{"sub": "user"}
+	for _, token := range []string{
+		sign(t, "RS256", "rsa1", rsa1, claims),
+		sign(t, "RS256", "", rsa1, claims),
+		sign(t, "ES256", "ec", ec, claims),
+		sign(t, "EdDSA", "ed", edPriv, claims),
+		sign(t, "HS256", "hmac", []byte("secret"), claims),
+	} {
+		if _, err := v.Validate(token); err != nil {
+			t.Errorf("unexpected error %s", err)
+		}
+	}
+	// key restricted to HS256
+	if _, err := v.Validate(sign(t, "HS512", "hmac", []byte("secret"), claims)); err != NoMatchingKey {
+		t.Errorf("expected NoMatchingKey got %v", err)
+	}
+	if _, err := v.Validate(sign(t, "RS256", "rsa2", rsa2, claims)); err != NoMatchingKey {
+		t.Errorf("expected NoMatchingKey got %v", err)
+	}
+	if _, err := ParseJWKS(jwks(t, map[string]interface{}{"kty": "EC", "crv": "P-256", "x": "AQAB", "y": "AQAB"})); err == nil {
+		t.Error("point not on curve accepted")
+	}
+
+	// file reload
+	fn, err := ioutil.TempFile("", "jwks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(fn.Name())
+	fn.Write(jwks(t, rsaJwk("rsa1", &rsa1.PublicKey)))
+	fn.Close()
+	f, err := OpenJwksFile(fn.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.watch.Interval = time.Nanosecond
+	v = &Validator{Keys: []KeySource{f}}
+	if _, err := v.Validate(sign(t, "RS256", "rsa1", rsa1, claims)); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+	if err := ioutil.WriteFile(fn.Name(), jwks(t, rsaJwk("rsa2", &rsa2.PublicKey)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// make sure mtime changes on filesystems with coarse timestamps
+	os.Chtimes(fn.Name(), time.Now().Add(time.Second), time.Now().Add(time.Second))
+	if _, err := v.Validate(sign(t, "RS256", "rsa2", rsa2, claims)); err != nil {
+		t.Errorf("unexpected error after reload %s", err)
+	}
+	if _, err := v.Validate(sign(t, "RS256", "rsa1", rsa1, claims)); err == nil {
+		t.Error("removed key still accepted")
+	}
+}
+
+func TestJwksURL(t *testing.T) {
+	rsa1, _ := rsa.GenerateKey(rand.Reader, 2048)
+	rsa2, _ := rsa.GenerateKey(rand.Reader, 2048)
+	current := jwks(t, rsaJwk("rsa1", &rsa1.PublicKey))
+	fetches := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		w.Write(current)
+	}))
+	defer srv.Close()
+
+	u := NewJwksURL(srv.URL, time.Hour)
+	v := &Validator{Keys: []KeySource{u}}
+	claims := map[string]interface{}{"sub": "user"}
+	for i := 0; i < 3; i++ {
+		if _, err := v.Validate(sign(t, "RS256", "rsa1", rsa1, claims)); err != nil {
+			t.Errorf("unexpected error %s", err)
+		}
+	}
+	if fetches != 1 {
+		t.Errorf("expected keys to be cached, got %d fetches", fetches)
+	}
+	// key rotation: unknown kid triggers refetch, but not more often than jwksMinRefresh
+	current = jwks(t, rsaJwk("rsa2", &rsa2.PublicKey))
+	if _, err := v.Validate(sign(t, "RS256", "rsa2", rsa2, claims)); err != NoMatchingKey {
+		t.Errorf("expected NoMatchingKey within min refresh interval, got %v", err)
+	}
+	u.attempted = u.attempted.Add(-jwksMinRefresh)
+	if _, err := v.Validate(sign(t, "RS256", "rsa2", rsa2, claims)); err != nil {
+		t.Errorf("unexpected error after rotation %s", err)
+	}
+	if fetches != 2 {
+		t.Errorf("expected 2 fetches got %d", fetches)
+	}
+	// failed fetch keeps cached keys
+	srv.Close()
+	u.attempted = u.attempted.Add(-jwksMinRefresh)
+	u.fetched = u.fetched.Add(-time.Hour)
+	if _, err := v.Validate(sign(t, "RS256", "rsa2", rsa2, claims)); err != nil {
+		t.Errorf("unexpected error with unavailable endpoint %s", err)
+	}
+}
+
+func TestJwksURLFetchInFlight(t *testing.T) {
+	rsa1, _ := rsa.GenerateKey(rand.Reader, 2048)
+	requested := make(chan bool, 1)
+	release := make(chan bool)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested <- true
+		<-release
+		w.Write(jwks(t, rsaJwk("rsa1", &rsa1.PublicKey)))
+	}))
+	defer srv.Close()
+
+	u := NewJwksURL(srv.URL, time.Hour)
+	fetched := make(chan []*Key)
+	go func() { fetched <- u.Keys(false) }()
+	<-requested
+	// other requests do not wait for the stalled endpoint and have no keys yet
+	done := make(chan []*Key)
+	go func() { done <- u.Keys(true) }()
+	select {
+	case keys := <-done:
+		if len(keys) != 0 {
+			t.Error("expected no keys while the first fetch is in flight ", keys)
+		}
+	case <-time.After(time.Second):
+		t.Error("Keys blocked by the fetch in flight")
+	}
+	close(release)
+	if keys := <-fetched; len(keys) != 1 {
+		t.Error("expected fetched key ", keys)
+	}
+	if keys := u.Keys(false); len(keys) != 1 {
+		t.Error("expected cached key ", keys)
+	}
+}
@@ -0,0 +1,340 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/apesternikov/backplane/src/filewatch"
+
+	"github.com/golang/glog"
+)
+
+var (
+	NoPEMBlock         = errors.New("No PEM block found")
+	UnsupportedKeyType = errors.New("Unsupported key type")
+)
+
+// Key is a public key or a shared secret used to verify token signatures
+type Key struct {
+	Kid    string // key id, matches any token kid if empty
+	Alg    string // restricts the key to a single algorithm if not empty
+	public interface{}
+	secret []byte
+	kt     keyType
+}
+
+func (k *Key) usableFor(hdr *Header, alg *algorithm) bool {
+	if k.kt != alg.keyType {
+		return false
+	}
+	if k.Alg != "" && k.Alg != hdr.Alg {
+		return false
+	}
+	return k.Kid == "" || hdr.Kid == "" || k.Kid == hdr.Kid
+}
+
+// NewHMACKey creates a key for HS256/HS384/HS512 tokens
+func NewHMACKey(secret []byte) *Key {
+	return &Key{secret: secret, kt: keyHMAC}
+}
+
+// NewPublicKey wraps *rsa.PublicKey, *ecdsa.PublicKey or ed25519.PublicKey
+func NewPublicKey(pub interface{}) (*Key, error) {
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		return &Key{public: pub, kt: keyRSA}, nil
+	case *ecdsa.PublicKey:
+		return &Key{public: pub, kt: keyEC}, nil
+	case ed25519.PublicKey:
+		return &Key{public: pub, kt: keyEd25519}, nil
+	}
+	return nil, UnsupportedKeyType
+}
+
+// ParsePEM parses PEM encoded public keys or certificates
+func ParsePEM(data []byte) ([]*Key, error) {
+	var keys []*Key
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		var pub interface{}
+		var err error
+		switch block.Type {
+		case "CERTIFICATE":
+			var cert *x509.Certificate
+			cert, err = x509.ParseCertificate(block.Bytes)
+			if err == nil {
+				pub = cert.PublicKey
+			}
+		case "RSA PUBLIC KEY":
+			pub, err = x509.ParsePKCS1PublicKey(block.Bytes)
+		default:
+			pub, err = x509.ParsePKIXPublicKey(block.Bytes)
+		}
+		if err != nil {
+			return nil, err
+		}
+		key, err := NewPublicKey(pub)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, NoPEMBlock
+	}
+	return keys, nil
+}
+
+// KeySource provides verification keys.
+// refresh is set when no key matched the token, sources able to reload keys from origin may do so.
+type KeySource interface {
+	Keys(refresh bool) []*Key
+}
+
+// StaticKeys is a fixed set of keys
+type StaticKeys []*Key
+
+func (s StaticKeys) Keys(refresh bool) []*Key {
+	return s
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	K   string `json:"k"`
+}
+
+// ParseJWKS parses a JSON Web Key Set (RFC 7517). Keys of unknown types or not intended for signatures are skipped.
+func ParseJWKS(data []byte) ([]*Key, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+	var keys []*Key
+	for i, jk := range set.Keys {
+		if jk.Use != "" && jk.Use != "sig" {
+			continue
+		}
+		key, err := jk.key()
+		if err == UnsupportedKeyType {
+			glog.V(2).Infof("skipping jwk %d (%s) of unsupported type %s", i, jk.Kid, jk.Kty)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("key %d: %s", i, err)
+		}
+		key.Kid = jk.Kid
+		key.Alg = jk.Alg
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func b64int(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func (jk *jwk) key() (*Key, error) {
+	switch jk.Kty {
+	case "RSA":
+		n, err := b64int(jk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := b64int(jk.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("bad RSA exponent")
+		}
+		return NewPublicKey(&rsa.PublicKey{N: n, E: int(e.Int64())})
+	case "EC":
+		var curve elliptic.Curve
+		switch jk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, UnsupportedKeyType
+		}
+		x, err := b64int(jk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := b64int(jk.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("EC point is not on curve")
+		}
+		return NewPublicKey(&ecdsa.PublicKey{Curve: curve, X: x, Y: y})
+	case "OKP":
+		if jk.Crv != "Ed25519" {
+			return nil, UnsupportedKeyType
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jk.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("bad Ed25519 key size")
+		}
+		return NewPublicKey(ed25519.PublicKey(x))
+	case "oct":
+		k, err := base64.RawURLEncoding.DecodeString(jk.K)
+		if err != nil {
+			return nil, err
+		}
+		return NewHMACKey(k), nil
+	}
+	return nil, UnsupportedKeyType
+}
+
+// JwksFile is a JWKS file reloaded when modified on disk
+type JwksFile struct {
+	watch *filewatch.File
+	mu    sync.RWMutex
+	keys  []*Key
+}
+
+// OpenJwksFile loads the file. Later reloads that fail keep the previously loaded keys.
+func OpenJwksFile(path string) (*JwksFile, error) {
+	f := &JwksFile{watch: filewatch.New(path)}
+	f.watch.Changed()
+	if err := f.load(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *JwksFile) load() error {
+	data, err := ioutil.ReadFile(f.watch.Path)
+	if err != nil {
+		return err
+	}
+	keys, err := ParseJWKS(data)
+	if err != nil {
+		return fmt.Errorf("%s: %s", f.watch.Path, err)
+	}
+	f.mu.Lock()
+	f.keys = keys
+	f.mu.Unlock()
+	glog.V(1).Infof("loaded %d keys from %s", len(keys), f.watch.Path)
+	return nil
+}
+
+func (f *JwksFile) Keys(refresh bool) []*Key {
+	if f.watch.Changed() {
+		if err := f.load(); err != nil {
+			glog.Errorf("Unable to reload JWKS file: %s", err)
+		}
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.keys
+}
+
+// minimal interval between fetches triggered by unknown keys
+const jwksMinRefresh = time.Minute
+
+// JwksURL fetches keys from a JWKS endpoint and caches them for CacheTime.
+// Fetch failures keep the previously fetched keys. Only one request at a time fetches the keys,
+// others use the cached keys meanwhile and fail to validate tokens while there are none.
+type JwksURL struct {
+	URL       string
+	CacheTime time.Duration
+	Client    *http.Client
+
+	mu        sync.Mutex
+	keys      []*Key
+	fetched   time.Time // last successful fetch
+	attempted time.Time // last fetch attempt
+	fetching  bool
+}
+
+func NewJwksURL(url string, cacheTime time.Duration) *JwksURL {
+	if cacheTime == 0 {
+		cacheTime = time.Hour
+	}
+	return &JwksURL{URL: url, CacheTime: cacheTime, Client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (u *JwksURL) Keys(refresh bool) []*Key {
+	u.mu.Lock()
+	now := time.Now()
+	stale := now.Sub(u.fetched) >= u.CacheTime
+	// the first fetch is always attempted
+	doFetch := u.attempted.IsZero() || (stale || refresh) && now.Sub(u.attempted) >= jwksMinRefresh
+	if !doFetch || u.fetching {
+		defer u.mu.Unlock()
+		return u.keys
+	}
+	u.attempted = now
+	u.fetching = true
+	u.mu.Unlock()
+
+	keys, err := u.fetch()
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.fetching = false
+	if err != nil {
+		glog.Errorf("Unable to fetch JWKS from %s: %s", u.URL, err)
+	} else {
+		u.keys = keys
+		u.fetched = now
+		glog.V(1).Infof("fetched %d keys from %s", len(keys), u.URL)
+	}
+	return u.keys
+}
+
+func (u *JwksURL) fetch() ([]*Key, error) {
+	resp, err := u.Client.Get(u.URL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return ParseJWKS(data)
+}
@@ -0,0 +1,320 @@
+// Package proxyproto reads and writes PROXY protocol v1 and v2 headers
+// (http://www.haproxy.org/download/1.8/doc/proxy-protocol.txt) used by
+// load balancers to pass the original client address over a TCP connection.
+package proxyproto
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	NoProxyHeader  = errors.New("No PROXY protocol header")
+	BadProxyHeader = errors.New("Malformed PROXY protocol header")
+)
+
+// v2 header signature
+var v2sig = []byte("\r\n\r\n\x00\r\nQUIT\n")
+
+const (
+	v1MaxLength = 107
+	v2CmdLocal  = 0x0
+	v2CmdProxy  = 0x1
+	v2FamTCP4   = 0x11
+	v2FamTCP6   = 0x21
+)
+
+// Header is a parsed PROXY header. Src and Dst are nil for LOCAL (health check)
+// connections and connections of unknown protocol; the real peer address should be used then.
+type Header struct {
+	Version int
+	Src     *net.TCPAddr
+	Dst     *net.TCPAddr
+}
+
+// ReadHeader reads the header from r. NoProxyHeader is returned if the data does not start with a
+// PROXY signature, nothing is consumed in this case.
+func ReadHeader(r *bufio.Reader) (*Header, error) {
+	b, err := r.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+	switch b[0] {
+	case 'P':
+		return readV1(r)
+	case '\r':
+		return readV2(r)
+	}
+	return nil, NoProxyHeader
+}
+
+func readV1(r *bufio.Reader) (*Header, error) {
+	b, err := r.Peek(6)
+	if err != nil {
+		return nil, err
+	}
+	if string(b) != "PROXY " {
+		return nil, NoProxyHeader
+	}
+	var line []byte
+	for len(line) < v1MaxLength {
+		c, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		line = append(line, c)
+		if c == '\n' {
+			break
+		}
+	}
+	if !bytes.HasSuffix(line, []byte("\r\n")) {
+		return nil, BadProxyHeader
+	}
+	fields := strings.Split(string(line[:len(line)-2]), " ")
+	h := &Header{Version: 1}
+	if len(fields) >= 2 && fields[1] == "UNKNOWN" {
+		return h, nil
+	}
+	if len(fields) != 6 || (fields[1] != "TCP4" && fields[1] != "TCP6") {
+		return nil, BadProxyHeader
+	}
+	h.Src, err = parseV1Addr(fields[1], fields[2], fields[4])
+	if err != nil {
+		return nil, err
+	}
+	h.Dst, err = parseV1Addr(fields[1], fields[3], fields[5])
+	if err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func parseV1Addr(proto, ip, port string) (*net.TCPAddr, error) {
+	addr := net.ParseIP(ip)
+	if addr == nil || (proto == "TCP4") != (addr.To4() != nil) {
+		return nil, BadProxyHeader
+	}
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return nil, BadProxyHeader
+	}
+	return &net.TCPAddr{IP: addr, Port: int(p)}, nil
+}
+
+func readV2(r *bufio.Reader) (*Header, error) {
+	b, err := r.Peek(16)
+	if err != nil {
+		if err == io.EOF || err == bufio.ErrBufferFull {
+			return nil, NoProxyHeader
+		}
+		return nil, err
+	}
+	if !bytes.Equal(b[:12], v2sig) {
+		return nil, NoProxyHeader
+	}
+	if b[12]>>4 != 2 {
+		return nil, BadProxyHeader
+	}
+	cmd := b[12] & 0xf
+	fam := b[13]
+	length := int(binary.BigEndian.Uint16(b[14:16]))
+	hdr := make([]byte, 16+length)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return nil, err
+	}
+	payload := hdr[16:]
+	h := &Header{Version: 2}
+	switch cmd {
+	case v2CmdLocal:
+		return h, nil
+	case v2CmdProxy:
+	default:
+		return nil, BadProxyHeader
+	}
+	switch fam {
+	case v2FamTCP4:
+		if len(payload) < 12 {
+			return nil, BadProxyHeader
+		}
+		h.Src = &net.TCPAddr{IP: net.IP(payload[0:4]), Port: int(binary.BigEndian.Uint16(payload[8:10]))}
+		h.Dst = &net.TCPAddr{IP: net.IP(payload[4:8]), Port: int(binary.BigEndian.Uint16(payload[10:12]))}
+	case v2FamTCP6:
+		if len(payload) < 36 {
+			return nil, BadProxyHeader
+		}
+		h.Src = &net.TCPAddr{IP: net.IP(payload[0:16]), Port: int(binary.BigEndian.Uint16(payload[32:34]))}
+		h.Dst = &net.TCPAddr{IP: net.IP(payload[16:32]), Port: int(binary.BigEndian.Uint16(payload[34:36]))}
+	}
+	// other families (UDP, unix sockets) are accepted but addresses are ignored. TLVs are skipped
+	return h, nil
+}
+
+// Format encodes the header. Version 1 or 2 is used as set in h.Version.
+// Missing or mixed family addresses are sent as UNKNOWN/LOCAL.
+func (h *Header) Format() []byte {
+	src, dst := h.Src, h.Dst
+	known := src != nil && dst != nil && (src.IP.To4() != nil) == (dst.IP.To4() != nil)
+	if h.Version == 1 {
+		if !known {
+			return []byte("PROXY UNKNOWN\r\n")
+		}
+		proto := "TCP6"
+		if src.IP.To4() != nil {
+			proto = "TCP4"
+		}
+		return []byte(fmt.Sprintf("PROXY %s %s %s %d %d\r\n", proto, src.IP, dst.IP, src.Port, dst.Port))
+	}
+	var buf bytes.Buffer
+	buf.Write(v2sig)
+	if !known {
+		buf.Write([]byte{0x20 | v2CmdLocal, 0, 0, 0})
+		return buf.Bytes()
+	}
+	buf.WriteByte(0x20 | v2CmdProxy)
+	if src4, dst4 := src.IP.To4(), dst.IP.To4(); src4 != nil {
+		buf.WriteByte(v2FamTCP4)
+		binary.Write(&buf, binary.BigEndian, uint16(12))
+		buf.Write(src4)
+		buf.Write(dst4)
+	} else {
+		buf.WriteByte(v2FamTCP6)
+		binary.Write(&buf, binary.BigEndian, uint16(36))
+		buf.Write(src.IP.To16())
+		buf.Write(dst.IP.To16())
+	}
+	binary.Write(&buf, binary.BigEndian, uint16(src.Port))
+	binary.Write(&buf, binary.BigEndian, uint16(dst.Port))
+	return buf.Bytes()
+}
+
+// Conn wraps a connection from a load balancer. The header is read lazily on the first
+// Read, RemoteAddr or LocalAddr call, so Accept is never blocked by slow clients.
+// Connections without a valid header fail on Read.
+type Conn struct {
+	net.Conn
+	Timeout time.Duration // time allowed for the header to arrive
+
+	once   sync.Once
+	r      *bufio.Reader
+	header *Header
+	err    error
+}
+
+func NewConn(c net.Conn, timeout time.Duration) *Conn {
+	return &Conn{Conn: c, Timeout: timeout}
+}
+
+func (c *Conn) readHeader() {
+	c.once.Do(func() {
+		c.r = bufio.NewReaderSize(c.Conn, 256)
+		if c.Timeout > 0 {
+			c.Conn.SetReadDeadline(time.Now().Add(c.Timeout))
+			defer c.Conn.SetReadDeadline(time.Time{})
+		}
+		c.header, c.err = ReadHeader(c.r)
+	})
+}
+
+// Header returns the parsed header or the error reading it
+func (c *Conn) Header() (*Header, error) {
+	c.readHeader()
+	return c.header, c.err
+}
+
+func (c *Conn) Read(b []byte) (int, error) {
+	c.readHeader()
+	if c.err != nil {
+		return 0, c.err
+	}
+	return c.r.Read(b)
+}
+
+func (c *Conn) RemoteAddr() net.Addr {
+	c.readHeader()
+	if c.header != nil && c.header.Src != nil {
+		return c.header.Src
+	}
+	return c.Conn.RemoteAddr()
+}
+
+func (c *Conn) LocalAddr() net.Addr {
+	c.readHeader()
+	if c.header != nil && c.header.Dst != nil {
+		return c.header.Dst
+	}
+	return c.Conn.LocalAddr()
+}
+
+type key int
+
+const (
+	headerKey key = iota
+	connKey
+)
+
+// NewContext returns a context carrying the header to be sent to upstream servers
+func NewContext(ctx context.Context, h *Header) context.Context {
+	return context.WithValue(ctx, headerKey, h)
+}
+
+// NewConnContext returns a context of the client connection, upstream servers get its addresses
+// unless the header is set with NewContext. Addresses are only looked up by FromContext, so
+// it fits http.Server.ConnContext: the PROXY header of c may not be read yet.
+func NewConnContext(ctx context.Context, c net.Conn) context.Context {
+	return context.WithValue(ctx, connKey, c)
+}
+
+// FromContext returns the header stored by NewContext, the addresses of the connection
+// stored by NewConnContext or nil
+func FromContext(ctx context.Context) *Header {
+	if h, ok := ctx.Value(headerKey).(*Header); ok {
+		return h
+	}
+	c, _ := ctx.Value(connKey).(net.Conn)
+	if c == nil {
+		return nil
+	}
+	src, _ := c.RemoteAddr().(*net.TCPAddr)
+	dst, _ := c.LocalAddr().(*net.TCPAddr)
+	if src == nil || dst == nil {
+		return nil
+	}
+	return &Header{Src: src, Dst: dst}
+}
+
+// Dialer connects to upstream servers and sends a PROXY header of the given version first.
+// The client address is taken from the dial context (see NewContext), LOCAL/UNKNOWN is sent without it.
+type Dialer struct {
+	*net.Dialer
+	Version int
+	Addr    string // if set, connect to this address instead of the requested one
+}
+
+func (d *Dialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	if d.Addr != "" {
+		addr = d.Addr
+	}
+	c, err := d.Dialer.DialContext(ctx, network, addr)
+	if err != nil {
+		return nil, err
+	}
+	h := Header{Version: d.Version}
+	if client := FromContext(ctx); client != nil {
+		h.Src, h.Dst = client.Src, client.Dst
+	}
+	if _, err := c.Write(h.Format()); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
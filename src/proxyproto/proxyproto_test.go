@@ -0,0 +1,193 @@
+package proxyproto
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadV1(t *testing.T) {
+	testcases := []struct {
+		in       string
+		src, dst string
+		err      error
+	}{
+		{"PROXY TCP4 1.2.3.4 5.6.7.8 1000 80\r\nGET /", "1.2.3.4:1000", "5.6.7.8:80", nil},
+		{"PROXY TCP6 2001:db8::1 2001:db8::2 1000 443\r\nGET /", "[2001:db8::1]:1000", "[2001:db8::2]:443", nil},
+		{"PROXY UNKNOWN\r\nGET /", "", "", nil},
+		{"PROXY UNKNOWN ffff::1 ffff::2 1 2\r\nGET /", "", "", nil},
+		{"PROXY TCP4 1.2.3.4 5.6.7.8 1000\r\nGET /", "", "", BadProxyHeader},
+		{"PROXY TCP4 2001:db8::1 5.6.7.8 1000 80\r\nGET /", "", "", BadProxyHeader},
+		{"PROXY TCP4 1.2.3.4 5.6.7.8 1000 70000\r\nGET /", "", "", BadProxyHeader},
+		{"PROXY TCP4 1.2.3.4 5.6.7.8 1000 80\nGET /", "", "", BadProxyHeader},
+		{"PROXY " + strings.Repeat("x", 200), "", "", BadProxyHeader},
+		{"POST / HTTP/1.1\r\n", "", "", NoProxyHeader},
+		{"GET / HTTP/1.1\r\n", "", "", NoProxyHeader},
+	}
+	for i, tc := range testcases {
+		r := bufio.NewReader(strings.NewReader(tc.in))
+		h, err := ReadHeader(r)
+		if err != tc.err {
+			t.Errorf("testcase %d: expected error %v got %v", i+1, tc.err, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if (h.Src == nil) != (tc.src == "") || (h.Src != nil && (h.Src.String() != tc.src || h.Dst.String() != tc.dst)) {
+			t.Errorf("testcase %d: unexpected addresses %v %v", i+1, h.Src, h.Dst)
+		}
+		if rest, _ := ioutil.ReadAll(r); string(rest) != "GET /" {
+			t.Errorf("testcase %d: header not fully consumed, rest %q", i+1, rest)
+		}
+	}
+}
+
+func TestFormatAndReadV2(t *testing.T) {
+	headers := []*Header{
+		{Version: 2, Src: &net.TCPAddr{IP: net.ParseIP("1.2.3.4"), Port: 1000}, Dst: &net.TCPAddr{IP: net.ParseIP("5.6.7.8"), Port: 80}},
+		{Version: 2, Src: &net.TCPAddr{IP: net.ParseIP("2001:db8::1"), Port: 1000}, Dst: &net.TCPAddr{IP: net.ParseIP("2001:db8::2"), Port: 443}},
+		{Version: 2},
+		{Version: 1, Src: &net.TCPAddr{IP: net.ParseIP("1.2.3.4"), Port: 1000}, Dst: &net.TCPAddr{IP: net.ParseIP("5.6.7.8"), Port: 80}},
+		{Version: 1},
+	}
+	for i, h := range headers {
+		r := bufio.NewReader(strings.NewReader(string(h.Format()) + "rest"))
+		got, err := ReadHeader(r)
+		if err != nil {
+			t.Errorf("testcase %d: unexpected error %s", i+1, err)
+			continue
+		}
+		if got.Version != h.Version || (h.Src == nil) != (got.Src == nil) ||
+			(h.Src != nil && (got.Src.String() != h.Src.String() || got.Dst.String() != h.Dst.String())) {
+			t.Errorf("testcase %d: expected %+v got %+v", i+1, h, got)
+		}
+		if rest, _ := ioutil.ReadAll(r); string(rest) != "rest" {
+			t.Errorf("testcase %d: unexpected rest %q", i+1, rest)
+		}
+	}
+	// TLVs are skipped
+	h := headers[0].Format()
+	h[15] += 5
+	r := bufio.NewReader(strings.NewReader(string(h) + "\x04\x00\x02abrest"))
+	if got, err := ReadHeader(r); err != nil || got.Src.String() != "1.2.3.4:1000" {
+		t.Errorf("unexpected result with TLV %v %v", got, err)
+	}
+	if rest, _ := ioutil.ReadAll(r); string(rest) != "rest" {
+		t.Errorf("TLV not skipped, rest %q", rest)
+	}
+	// unsupported version
+	h = headers[0].Format()
+	h[12] = 0x31
+	if _, err := ReadHeader(bufio.NewReader(strings.NewReader(string(h)))); err != BadProxyHeader {
+		t.Errorf("expected BadProxyHeader got %v", err)
+	}
+}
+
+func TestConn(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	c := NewConn(server, time.Second)
+	go client.Write([]byte("PROXY TCP4 1.2.3.4 5.6.7.8 1000 80\r\nhello"))
+	if c.RemoteAddr().String() != "1.2.3.4:1000" || c.LocalAddr().String() != "5.6.7.8:80" {
+		t.Errorf("unexpected addresses %s %s", c.RemoteAddr(), c.LocalAddr())
+	}
+	buf := make([]byte, 5)
+	if n, err := c.Read(buf); err != nil || string(buf[:n]) != "hello" {
+		t.Errorf("unexpected read %q %v", buf[:n], err)
+	}
+
+	// missing header fails reads, addresses fall back to the real ones
+	client, server = net.Pipe()
+	defer client.Close()
+	c = NewConn(server, time.Second)
+	go client.Write([]byte("GET / HTTP/1.0\r\n\r\n"))
+	if _, err := c.Read(buf); err != NoProxyHeader {
+		t.Errorf("expected NoProxyHeader got %v", err)
+	}
+	if c.RemoteAddr() != server.RemoteAddr() {
+		t.Errorf("expected real remote address")
+	}
+
+	// slow clients time out
+	client, server = net.Pipe()
+	defer client.Close()
+	c = NewConn(server, 10*time.Millisecond)
+	if _, err := c.Read(buf); err == nil {
+		t.Error("expected timeout")
+	}
+}
+
+func TestDialer(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	headers := make(chan *Header, 2)
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			h, err := ReadHeader(bufio.NewReader(c))
+			if err != nil {
+				t.Error(err)
+			}
+			headers <- h
+			c.Close()
+		}
+	}()
+	d := &Dialer{Dialer: &net.Dialer{}, Version: 2, Addr: ln.Addr().String()}
+	client := &Header{Src: &net.TCPAddr{IP: net.ParseIP("1.2.3.4"), Port: 1000}, Dst: &net.TCPAddr{IP: net.ParseIP("5.6.7.8"), Port: 80}}
+	c, err := d.DialContext(NewContext(context.Background(), client), "tcp", "ignored:80")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Close()
+	if h := <-headers; h.Version != 2 || h.Src.String() != "1.2.3.4:1000" {
+		t.Errorf("unexpected header %+v", h)
+	}
+	c, err = d.DialContext(context.Background(), "tcp", "ignored:80")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Close()
+	if h := <-headers; h.Version != 2 || h.Src != nil {
+		t.Errorf("expected LOCAL header, got %+v", h)
+	}
+}
+
+func TestConnContext(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	c, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	ctx := NewConnContext(context.Background(), c)
+	if h := FromContext(ctx); h == nil || h.Src.String() != c.RemoteAddr().String() || h.Dst.String() != c.LocalAddr().String() {
+		t.Errorf("unexpected header of the connection %+v", h)
+	}
+	client := &Header{Src: &net.TCPAddr{IP: net.ParseIP("1.2.3.4"), Port: 1000}}
+	if h := FromContext(NewContext(ctx, client)); h != client {
+		t.Errorf("expected the header to override the connection, got %+v", h)
+	}
+	if h := FromContext(NewConnContext(context.Background(), pipeConn())); h != nil {
+		t.Errorf("expected no header without tcp addresses, got %+v", h)
+	}
+}
+
+func pipeConn() net.Conn {
+	c, _ := net.Pipe()
+	return c
+}
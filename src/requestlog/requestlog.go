@@ -101,10 +101,16 @@ func itemToInfluxPoint(it *Item, l *line) {
 	l.WriteString(strconv.Itoa(int(it.StatusCode)))
 	l.WriteString(",IsTls=")
 	l.WriteString(strconv.FormatBool(it.IsTls))
+	if it.GrpcStatus != "" {
+		l.WriteString(",GrpcStatus=")
+		l.WriteEscaped(it.GrpcStatus)
+	}
 
 	l.WriteByte(' ')
 	l.WriteString("ClientIp=")
 	l.WriteQuoted(it.ClientIp)
+	l.WriteString(",User=")
+	l.WriteQuoted(it.User)
 	l.WriteString(",Referrer=")
 	l.WriteQuoted(it.Referrer)
 	l.WriteString(",RequestUri=")
@@ -35,6 +35,8 @@ type Item struct {
 	HandlerPath       string `protobuf:"bytes,13,opt,name=handler_path" json:"handler_path,omitempty"`
 	BackendName       string `protobuf:"bytes,14,opt,name=backend_name" json:"backend_name,omitempty"`
 	ServerAddress     string `protobuf:"bytes,15,opt,name=server_address" json:"server_address,omitempty"`
+	User              string `protobuf:"bytes,16,opt,name=user" json:"user,omitempty"`
+	GrpcStatus        string `protobuf:"bytes,17,opt,name=grpc_status" json:"grpc_status,omitempty"`
 	FrontendLatencyNs int64  `protobuf:"varint,100,opt,name=frontend_latency_ns" json:"frontend_latency_ns,omitempty"`
 	ServerLatencyNs   int64  `protobuf:"varint,101,opt,name=server_latency_ns" json:"server_latency_ns,omitempty"`
 }
@@ -0,0 +1,108 @@
+package swim
+
+import (
+	"math"
+	"sort"
+
+	"github.com/apesternikov/backplane/src/gen"
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+)
+
+// Infection-style dissemination: every update is piggybacked on retransmitMult*log10(N+1) outbound
+// packets, the least sent and the newest first, as many as fit into maxPacketSize.
+// Rate report of this node goes first, reports of other nodes take the space left.
+// The packet size stays the same whatever the size of the cluster is.
+
+// lambda of the SWIM paper
+var retransmitMult = 3
+
+// budget of a single UDP packet, small enough to avoid fragmentation
+var maxPacketSize = 1400
+
+type broadcast struct {
+	update    *gen.DisseminationUpdateMsg
+	id        int64 //the order of queueing, newer updates are sent first
+	transmits int
+}
+
+type byPriority []*broadcast
+
+func (b byPriority) Len() int { return len(b) }
+func (b byPriority) Less(i, j int) bool {
+	if b[i].transmits != b[j].transmits {
+		return b[i].transmits < b[j].transmits
+	}
+	return b[i].id > b[j].id
+}
+func (b byPriority) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+
+// size of a repeated message field in the packet
+func fieldSize(m proto.Message) int {
+	n := proto.Size(m)
+	return 1 + proto.SizeVarint(uint64(n)) + n
+}
+
+// queue the update for dissemination replacing pending update about the same node and log it.
+// assumes s is locked
+func (s *Swim) broadcast(update *gen.DisseminationUpdateMsg) {
+	s.appendLog(update)
+	s.queued++
+	b := &broadcast{update: update, id: s.queued}
+	for i, q := range s.queue {
+		if q.update.NodeName == update.NodeName {
+			s.queue[i] = b
+			return
+		}
+	}
+	s.queue = append(s.queue, b)
+}
+
+// number of times every update is sent
+// assumes s is locked
+func (s *Swim) retransmitLimit() int {
+	return retransmitMult * int(math.Ceil(math.Log10(float64(len(s.nodes)+2))))
+}
+
+// attach queued updates and rate reports to the outbound packet while it fits into maxPacketSize.
+// Updates of the destination node not known to be alive go first regardless of the queue,
+// so the node learns it has to refute them.
+func (s *Swim) piggyback(msg *gen.SwimMessage, dest string) {
+	msg.DisseminationUpdates = nil
+	msg.RateReports = nil
+	budget := maxPacketSize - proto.Size(msg)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r := s.ownRateReport(); r != nil {
+		msg.RateReports = append(msg.RateReports, r)
+		budget -= fieldSize(r)
+	}
+	var sent *gen.DisseminationUpdateMsg
+	if n, ok := s.nodesmap[dest]; ok && n.update != nil && n.update.State != gen.NodeState_ALIVE {
+		sent = n.update
+		msg.DisseminationUpdates = append(msg.DisseminationUpdates, sent)
+		budget -= fieldSize(sent)
+	}
+	sort.Sort(byPriority(s.queue))
+	limit := s.retransmitLimit()
+	queue := s.queue[:0]
+	for _, b := range s.queue {
+		if b.update == sent {
+			b.transmits++
+		} else if size := fieldSize(b.update); size <= budget {
+			msg.DisseminationUpdates = append(msg.DisseminationUpdates, b.update)
+			budget -= size
+			b.transmits++
+		}
+		if b.transmits < limit {
+			queue = append(queue, b)
+		} else {
+			glog.V(3).Info("update disseminated ", b.update)
+		}
+	}
+	for i := len(queue); i < len(s.queue); i++ {
+		s.queue[i] = nil
+	}
+	s.queue = queue
+	msg.RateReports = append(msg.RateReports, s.rateReports(budget)...)
+}
@@ -0,0 +1,93 @@
+package swim
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apesternikov/backplane/src/gen"
+	"github.com/golang/protobuf/proto"
+)
+
+func TestPiggybackRetransmits(t *testing.T) {
+	s, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s.Close()
+	s.AddHosts("127.0.0.1:1", "127.0.0.1:2")
+	s.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate("127.0.0.1:1", "127.0.0.1:1", gen.NodeState_ALIVE, 0)})
+	limit := s.retransmitLimit()
+	if limit != 3 {
+		t.Error("Unexpected retransmit limit ", limit)
+	}
+	var msg gen.SwimMessage
+	s.piggyback(&msg, "")
+	// newest update first
+	if len(msg.DisseminationUpdates) != 2 || msg.DisseminationUpdates[0].NodeName != "127.0.0.1:1" || msg.DisseminationUpdates[1].NodeName != s.name {
+		t.Fatal("Unexpected updates ", msg.DisseminationUpdates)
+	}
+	// newer update replaces pending one and goes first as the least sent
+	s.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate("127.0.0.1:1", "127.0.0.1:2", gen.NodeState_SUSPECT, 0)})
+	s.piggyback(&msg, "")
+	if len(msg.DisseminationUpdates) != 2 || msg.DisseminationUpdates[0].State != gen.NodeState_SUSPECT {
+		t.Fatal("Unexpected updates ", msg.DisseminationUpdates)
+	}
+	for i := 2; i < limit; i++ {
+		s.piggyback(&msg, "")
+	}
+	// self update has been sent limit times
+	s.piggyback(&msg, "")
+	if len(msg.DisseminationUpdates) != 1 || msg.DisseminationUpdates[0].State != gen.NodeState_SUSPECT {
+		t.Fatal("Unexpected updates ", msg.DisseminationUpdates)
+	}
+	s.piggyback(&msg, "")
+	if len(msg.DisseminationUpdates) != 0 || len(s.queue) != 0 {
+		t.Fatal("Unexpected updates ", msg.DisseminationUpdates, s.queue)
+	}
+	// the suspected node learns about the suspicion whenever it is contacted
+	s.piggyback(&msg, "127.0.0.1:1")
+	if len(msg.DisseminationUpdates) != 1 || msg.DisseminationUpdates[0].State != gen.NodeState_SUSPECT {
+		t.Fatal("Unexpected updates ", msg.DisseminationUpdates)
+	}
+}
+
+func TestPiggybackBudget(t *testing.T) {
+	s, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s.Close()
+	var updates []*gen.DisseminationUpdateMsg
+	for i := 0; i < 1000; i++ {
+		name := fmt.Sprintf("10.0.%d.%d:7946", i/256, i%256)
+		updates = append(updates, newUpdate(name, name, gen.NodeState_ALIVE, 0))
+	}
+	s.onUpdatePkts(updates)
+	s.ExchangeRates(map[string]float64{"backend b": 10})
+	limit := s.retransmitLimit()
+	if limit != 12 {
+		t.Error("Unexpected retransmit limit ", limit)
+	}
+	sent := make(map[string]int)
+	for i := 0; len(s.queue) > 0; i++ {
+		msg := gen.SwimMessage{Seq: int64(i), Ping: &gen.Ping{SourceNode: s.name}}
+		s.piggyback(&msg, "")
+		if size := proto.Size(&msg); size > maxPacketSize {
+			t.Fatal("Packet is too big ", size)
+		}
+		if len(msg.DisseminationUpdates) == 0 || len(msg.RateReports) != 1 {
+			t.Fatal("Expected packet to be filled ", msg.DisseminationUpdates, msg.RateReports)
+		}
+		for _, u := range msg.DisseminationUpdates {
+			sent[u.NodeName]++
+		}
+	}
+	if len(sent) != 1001 {
+		t.Error("Expected all updates to be sent, got ", len(sent))
+	}
+	for name, cnt := range sent {
+		if cnt != limit {
+			t.Errorf("Expected %s to be sent %d times, got %d", name, limit, cnt)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package swim
+
+import (
+	"fmt"
+	"time"
+)
+
+// Protocol parameters: every ProbeInterval the node probes the next node in round-robin order
+// over the shuffled list of nodes. The target not acking a direct ping within ProbeTimeout is pinged
+// through IndirectProbes distinct live nodes, and suspected if none of them gets an ack either.
+// Suspicion not refuted within SuspicionMult protocol periods confirms the node dead.
+// Detection time does not depend on the cluster size: every node is probed about once a period.
+
+// Config holds protocol parameters. All nodes of the cluster should use the same parameters
+type Config struct {
+	ProbeInterval  time.Duration //protocol period
+	ProbeTimeout   time.Duration //round trip of a direct ping, indirect pings wait twice as long
+	IndirectProbes int           //number of nodes asked to ping the target, 0 suspects it right away
+	SuspicionMult  int           //suspicion timeout in protocol periods
+}
+
+// DefaultConfig returns parameters suitable for a local network
+func DefaultConfig() Config {
+	return Config{
+		ProbeInterval:  time.Second,
+		ProbeTimeout:   200 * time.Millisecond,
+		IndirectProbes: 3,
+		SuspicionMult:  5,
+	}
+}
+
+func (c Config) validate() error {
+	switch {
+	case c.ProbeInterval <= 0:
+		return fmt.Errorf("probe interval %s is not positive", c.ProbeInterval)
+	case c.ProbeTimeout <= 0:
+		return fmt.Errorf("probe timeout %s is not positive", c.ProbeTimeout)
+	case c.IndirectProbes < 0:
+		return fmt.Errorf("number of indirect probes %d is negative", c.IndirectProbes)
+	case c.SuspicionMult < 1:
+		return fmt.Errorf("suspicion multiplier %d is less than 1", c.SuspicionMult)
+	}
+	return nil
+}
+
+// suspected nodes not refuting the suspicion for this long are confirmed dead
+func (c Config) suspicionTimeout() time.Duration {
+	return time.Duration(c.SuspicionMult) * c.ProbeInterval
+}
+
+// SetConfig changes protocol parameters, it must be called before Serve
+func (s *Swim) SetConfig(c Config) error {
+	if err := c.validate(); err != nil {
+		return err
+	}
+	s.config = c
+	return nil
+}
+
+// Config returns protocol parameters
+func (s *Swim) Config() Config {
+	return s.config
+}
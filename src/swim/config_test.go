@@ -0,0 +1,36 @@
+package swim
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConfig(t *testing.T) {
+	s, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s.Close()
+	if s.Config() != DefaultConfig() {
+		t.Error("Expected default config, got ", s.Config())
+	}
+	for _, change := range []func(*Config){
+		func(c *Config) { c.ProbeInterval = 0 },
+		func(c *Config) { c.ProbeTimeout = -time.Second },
+		func(c *Config) { c.IndirectProbes = -1 },
+		func(c *Config) { c.SuspicionMult = 0 },
+	} {
+		c := DefaultConfig()
+		change(&c)
+		if err := s.SetConfig(c); err == nil {
+			t.Error("Expected invalid config error ", c)
+		}
+	}
+	c := Config{ProbeInterval: 500 * time.Millisecond, ProbeTimeout: 100 * time.Millisecond, SuspicionMult: 4}
+	if err := s.SetConfig(c); err != nil {
+		t.Fatal(err)
+	}
+	if s.Config() != c || c.suspicionTimeout() != 2*time.Second {
+		t.Error("Unexpected config ", s.Config())
+	}
+}
@@ -0,0 +1,186 @@
+package swim
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/apesternikov/backplane/src/gen"
+	"github.com/golang/glog"
+)
+
+type EventType int
+
+const (
+	MemberJoin    EventType = iota // first heard of alive or came back from DEAD
+	MemberUpdate                   // alive member refuted suspicion or changed its metadata
+	MemberSuspect                  // failed to respond, may be confirmed dead
+	MemberDead                     // confirmed dead by other members
+	MemberLeave                    // announced it is leaving the cluster
+)
+
+var eventTypeNames = []string{"join", "update", "suspect", "dead", "leave"}
+
+func (t EventType) String() string {
+	if int(t) < len(eventTypeNames) {
+		return eventTypeNames[t]
+	}
+	return fmt.Sprintf("EventType(%d)", int(t))
+}
+
+// Member is a snapshot of the member state, it does not change with the membership
+type Member struct {
+	Name        string // host:port of swim
+	State       gen.NodeState
+	Incarnation int64
+	LastChanged time.Time
+	Meta        map[string]string // metadata announced by the member
+}
+
+// Up tells if the member is alive or suspected
+func (m Member) Up() bool {
+	return m.State != gen.NodeState_DEAD
+}
+
+type Event struct {
+	Type   EventType
+	Member Member
+}
+
+func (e Event) String() string {
+	return fmt.Sprintf("%s %s %s incarnation %d", e.Type, e.Member.Name, e.Member.State, e.Member.Incarnation)
+}
+
+func (n *node) member() Member {
+	return Member{Name: n.name, State: n.update.State, Incarnation: n.update.Incarnation, LastChanged: n.lastChanged, Meta: copyMeta(n.update.Meta)}
+}
+
+// events queued for a subscriber not reading them are dropped beyond that, oldest first
+const maxPendingEvents = 1024
+
+type subscriber struct {
+	ch     chan<- Event
+	events []Event //not yet delivered
+	signal chan struct{}
+	done   chan struct{} //closed on unsubscribe
+}
+
+// Subscribe delivers membership events to the channel in the order they happen.
+// Every subscriber has its own queue and delivery goroutine, a slow subscriber delays
+// neither other subscribers nor the protocol. Events about this node are not delivered.
+func (s *Swim) Subscribe(ch chan<- Event) {
+	sub := &subscriber{ch: ch, signal: make(chan struct{}, 1), done: make(chan struct{})}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscribers = append(s.subscribers, sub)
+	go s.dispatchEvents(sub)
+}
+
+// Unsubscribe stops delivery of events to the channel, an event already being delivered
+// may still be sent to it
+func (s *Swim) Unsubscribe(ch chan<- Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, sub := range s.subscribers {
+		if sub.ch == ch {
+			s.subscribers = append(s.subscribers[:i], s.subscribers[i+1:]...)
+			close(sub.done)
+			return
+		}
+	}
+}
+
+// Members returns all known members including this node, first in the list.
+// Hosts added with AddHosts and never heard of are not members.
+func (s *Swim) Members() []Member {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	members := []Member{s.selfMember()}
+	for _, n := range s.nodes {
+		if n.update != nil {
+			members = append(members, n.member())
+		}
+	}
+	return members
+}
+
+// Member returns the member by name
+func (s *Swim) Member(name string) (m Member, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if name == s.name {
+		return s.selfMember(), true
+	}
+	n, ok := s.nodesmap[name]
+	if !ok || n.update == nil {
+		return Member{}, false
+	}
+	return n.member(), true
+}
+
+// assumes s is locked
+func (s *Swim) selfMember() Member {
+	return Member{Name: s.name, State: s.self.State, Incarnation: s.self.Incarnation, LastChanged: time.Unix(0, s.self.Timestamp), Meta: copyMeta(s.self.Meta)}
+}
+
+// the node state has changed from old: disseminate the update and notify subscribers
+// assumes s is locked
+func (s *Swim) changed(n *node, old *gen.DisseminationUpdateMsg) {
+	n.lastChanged = s.transport.Now()
+	s.broadcast(n.update)
+	var t EventType
+	switch n.update.State {
+	case gen.NodeState_ALIVE:
+		if old == nil || old.State == gen.NodeState_DEAD {
+			t = MemberJoin
+		} else {
+			t = MemberUpdate
+		}
+	case gen.NodeState_SUSPECT:
+		t = MemberSuspect
+	case gen.NodeState_DEAD:
+		if n.update.Origin == n.name {
+			t = MemberLeave
+		} else {
+			t = MemberDead
+		}
+	}
+	ev := Event{Type: t, Member: n.member()}
+	glog.V(1).Info("Swim: member event ", ev)
+	for _, sub := range s.subscribers {
+		if len(sub.events) >= maxPendingEvents {
+			glog.Errorf("Swim: subscriber is not reading events, dropping %s", sub.events[0])
+			sub.events = sub.events[1:]
+		}
+		sub.events = append(sub.events, ev)
+		select {
+		case sub.signal <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// deliver events to the subscriber until it unsubscribes or the swim is closed
+func (s *Swim) dispatchEvents(sub *subscriber) {
+	for {
+		select {
+		case <-s.closed:
+			return
+		case <-sub.done:
+			return
+		case <-sub.signal:
+		}
+		s.mu.Lock()
+		events := sub.events
+		sub.events = nil
+		s.mu.Unlock()
+		for _, ev := range events {
+			select {
+			case sub.ch <- ev:
+			case <-sub.done:
+				return
+			case <-s.closed:
+				return
+			}
+		}
+	}
+}
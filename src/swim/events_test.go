@@ -0,0 +1,135 @@
+package swim
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apesternikov/backplane/src/gen"
+)
+
+func expectEvent(t *testing.T, ch <-chan Event, typ EventType, name string, incarnation int64) {
+	select {
+	case ev := <-ch:
+		if ev.Type != typ || ev.Member.Name != name || ev.Member.Incarnation != incarnation {
+			t.Errorf("Expected %s %s %d, got %s", typ, name, incarnation, ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected %s %s %d, got nothing", typ, name, incarnation)
+	}
+}
+
+func TestMemberEvents(t *testing.T) {
+	s, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s.Close()
+	ch := make(chan Event)
+	s.Subscribe(ch)
+	const name = "10.0.0.1:7946"
+	for _, u := range []*gen.DisseminationUpdateMsg{
+		newUpdate(name, name, gen.NodeState_ALIVE, 0),
+		newUpdate(name, "10.0.0.2:7946", gen.NodeState_SUSPECT, 0),
+		newUpdate(name, name, gen.NodeState_ALIVE, 1),
+		newUpdate(name, "10.0.0.2:7946", gen.NodeState_DEAD, 1),
+		newUpdate(name, name, gen.NodeState_ALIVE, 2),
+		newUpdate(name, name, gen.NodeState_DEAD, 2),
+	} {
+		s.onUpdatePkts([]*gen.DisseminationUpdateMsg{u})
+	}
+	// stale update is not an event
+	s.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate(name, name, gen.NodeState_ALIVE, 1)})
+	expectEvent(t, ch, MemberJoin, name, 0)
+	expectEvent(t, ch, MemberSuspect, name, 0)
+	expectEvent(t, ch, MemberUpdate, name, 1)
+	expectEvent(t, ch, MemberDead, name, 1)
+	expectEvent(t, ch, MemberJoin, name, 2)
+	expectEvent(t, ch, MemberLeave, name, 2)
+	select {
+	case ev := <-ch:
+		t.Error("Unexpected event ", ev)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	s.AddHosts("10.0.0.3:7946")
+	members := s.Members()
+	if len(members) != 2 || members[0].Name != s.name || !members[0].Up() || members[1].Name != name || members[1].Up() {
+		t.Error("Unexpected members ", members)
+	}
+	if m, ok := s.Member(name); !ok || m.State != gen.NodeState_DEAD || m.Incarnation != 2 || m.LastChanged.IsZero() {
+		t.Error("Unexpected member ", m)
+	}
+	if _, ok := s.Member("10.0.0.3:7946"); ok {
+		t.Error("Hosts never heard of are not members")
+	}
+	// snapshots do not change
+	s.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate(name, name, gen.NodeState_ALIVE, 3)})
+	if members[1].Incarnation != 2 {
+		t.Error("Unexpected snapshot change ", members[1])
+	}
+	expectEvent(t, ch, MemberJoin, name, 3)
+	s.Unsubscribe(ch)
+	s.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate(name, "10.0.0.2:7946", gen.NodeState_SUSPECT, 3)})
+	select {
+	case ev := <-ch:
+		t.Error("Unexpected event after unsubscribe ", ev)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestLeave(t *testing.T) {
+	s1, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s1.Close()
+	s2, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s2.Close()
+	s1.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate(s2.name, s2.name, gen.NodeState_ALIVE, 0)})
+	ch := make(chan Event, 10)
+	s2.Subscribe(ch)
+	go s2.serveOnce()
+	if err := s1.Leave(); err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+	expectEvent(t, ch, MemberLeave, s1.name, 0)
+	// no refutation after leave
+	s1.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate(s1.name, s2.name, gen.NodeState_DEAD, 0)})
+	if m, _ := s1.Member(s1.name); m.State != gen.NodeState_DEAD || m.Incarnation != 0 {
+		t.Error("Unexpected state after leave ", m)
+	}
+}
+
+func TestStalledSubscriber(t *testing.T) {
+	s, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s.Close()
+	stalled := make(chan Event)
+	gone := make(chan Event)
+	ch := make(chan Event, 10)
+	s.Subscribe(stalled)
+	s.Subscribe(gone)
+	s.Subscribe(ch)
+	const name = "10.0.0.1:7946"
+	s.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate(name, name, gen.NodeState_ALIVE, 0)})
+	expectEvent(t, ch, MemberJoin, name, 0)
+	// unsubscribed while its event is being delivered
+	s.Unsubscribe(gone)
+	s.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate(name, "10.0.0.2:7946", gen.NodeState_SUSPECT, 0)})
+	expectEvent(t, ch, MemberSuspect, name, 0)
+	for i := 0; i < maxPendingEvents+10; i++ {
+		s.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate(name, name, gen.NodeState_ALIVE, int64(i+1))})
+		expectEvent(t, ch, MemberUpdate, name, int64(i+1))
+	}
+	s.mu.Lock()
+	pending := len(s.subscribers[0].events)
+	s.mu.Unlock()
+	if pending > maxPendingEvents {
+		t.Error("Unexpected events queued for the stalled subscriber ", pending)
+	}
+}
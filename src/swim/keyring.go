@@ -0,0 +1,196 @@
+package swim
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/golang/glog"
+)
+
+// Encryption: with a keyring every UDP packet and push-pull message is sealed with AES-GCM
+// under the primary key and opened with any key of the ring. Packets failing authentication
+// are dropped and counted. Keys are rotated without splitting the cluster: add the new key
+// on all nodes, make it primary on all nodes, then remove the old one.
+
+// sealed packet starts with the version byte followed by the nonce
+const (
+	encryptionVersion = 1
+	nonceSize         = 12
+	// added to the packet size by encryption: version, nonce and GCM tag
+	encryptionOverhead = 1 + nonceSize + 16
+)
+
+var (
+	noKeys          = errors.New("keyring has no keys")
+	primaryKey      = errors.New("primary key can not be removed")
+	unknownKey      = errors.New("key is not in the keyring")
+	badPacket       = errors.New("packet is not encrypted")
+	decryptionError = errors.New("no key decrypts the packet")
+)
+
+type keyringKey struct {
+	key  []byte
+	aead cipher.AEAD
+}
+
+// Keyring holds AES keys of 16, 24 or 32 bytes, the primary key first
+type Keyring struct {
+	mu   sync.Mutex
+	keys []keyringKey
+}
+
+// NewKeyring creates the keyring with the first key as primary
+func NewKeyring(keys ...[]byte) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, noKeys
+	}
+	k := &Keyring{}
+	for _, key := range keys {
+		if err := k.AddKey(key); err != nil {
+			return nil, err
+		}
+	}
+	return k, nil
+}
+
+// AddKey accepts packets sealed with the key, the first key added becomes primary
+func (k *Keyring) AddKey(key []byte) error {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.find(key) >= 0 {
+		return nil
+	}
+	keys := append([]keyringKey(nil), k.keys...)
+	k.keys = append(keys, keyringKey{key: append([]byte(nil), key...), aead: aead})
+	return nil
+}
+
+// UseKey makes the key primary, it must be in the keyring
+func (k *Keyring) UseKey(key []byte) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	i := k.find(key)
+	if i < 0 {
+		return unknownKey
+	}
+	// copy on write, packets are opened with a snapshot of keys
+	keys := append([]keyringKey{k.keys[i]}, k.keys[:i]...)
+	k.keys = append(keys, k.keys[i+1:]...)
+	return nil
+}
+
+// RemoveKey stops accepting packets sealed with the key
+func (k *Keyring) RemoveKey(key []byte) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	i := k.find(key)
+	switch {
+	case i < 0:
+		return unknownKey
+	case i == 0:
+		return primaryKey
+	}
+	keys := append([]keyringKey(nil), k.keys[:i]...)
+	k.keys = append(keys, k.keys[i+1:]...)
+	return nil
+}
+
+// Keys returns copies of all keys, the primary key first
+func (k *Keyring) Keys() [][]byte {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	keys := make([][]byte, 0, len(k.keys))
+	for _, key := range k.keys {
+		keys = append(keys, append([]byte(nil), key.key...))
+	}
+	return keys
+}
+
+// assumes k is locked
+func (k *Keyring) find(key []byte) int {
+	for i := range k.keys {
+		if bytes.Equal(k.keys[i].key, key) {
+			return i
+		}
+	}
+	return -1
+}
+
+// encrypt the packet with the primary key
+func (k *Keyring) seal(plain []byte) ([]byte, error) {
+	k.mu.Lock()
+	aead := k.keys[0].aead
+	k.mu.Unlock()
+	out := make([]byte, 1+nonceSize, encryptionOverhead+len(plain))
+	out[0] = encryptionVersion
+	if _, err := rand.Read(out[1:]); err != nil {
+		return nil, err
+	}
+	return aead.Seal(out, out[1:], plain, nil), nil
+}
+
+// decrypt and authenticate the packet with any key
+func (k *Keyring) open(sealed []byte) ([]byte, error) {
+	if len(sealed) < encryptionOverhead || sealed[0] != encryptionVersion {
+		return nil, badPacket
+	}
+	k.mu.Lock()
+	keys := k.keys
+	k.mu.Unlock()
+	nonce, ciphertext := sealed[1:1+nonceSize], sealed[1+nonceSize:]
+	for _, key := range keys {
+		if plain, err := key.aead.Open(nil, nonce, ciphertext, nil); err == nil {
+			return plain, nil
+		}
+	}
+	return nil, decryptionError
+}
+
+// SetKeyring enables encryption, it must be called before Serve
+func (s *Swim) SetKeyring(k *Keyring) {
+	s.keyring = k
+}
+
+// Encrypted tells if packets are encrypted
+func (s *Swim) Encrypted() bool {
+	return s.keyring != nil
+}
+
+// AuthFailures returns the number of packets dropped because they failed authentication
+func (s *Swim) AuthFailures() int64 {
+	return atomic.LoadInt64(&s.authFailures)
+}
+
+// seal the packet if encryption is enabled
+func (s *Swim) seal(bv []byte) ([]byte, error) {
+	if s.keyring == nil {
+		return bv, nil
+	}
+	return s.keyring.seal(bv)
+}
+
+// open the packet if encryption is enabled, counting packets failing authentication
+func (s *Swim) open(bv []byte, from string) ([]byte, error) {
+	if s.keyring == nil {
+		return bv, nil
+	}
+	plain, err := s.keyring.open(bv)
+	if err != nil {
+		atomic.AddInt64(&s.authFailures, 1)
+		glog.V(1).Infof("Swim: dropping packet from %s: %s", from, err)
+	}
+	return plain, err
+}
@@ -0,0 +1,139 @@
+package swim
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/apesternikov/backplane/src/gen"
+)
+
+var (
+	key1 = bytes.Repeat([]byte{1}, 16)
+	key2 = bytes.Repeat([]byte{2}, 32)
+)
+
+func TestKeyring(t *testing.T) {
+	if _, err := NewKeyring(); err != noKeys {
+		t.Error("Expected no keys error, got ", err)
+	}
+	if _, err := NewKeyring([]byte("short")); err == nil {
+		t.Error("Expected bad key size error")
+	}
+	k1, err := NewKeyring(key1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// rotation started on the second node: it sends with the new key and still accepts the old one
+	k2, err := NewKeyring(key2, key1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain := []byte("hello")
+	sealed, err := k1.seal(plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sealed) != len(plain)+encryptionOverhead || bytes.Contains(sealed, plain) {
+		t.Error("Unexpected sealed packet ", sealed)
+	}
+	if got, err := k2.open(sealed); err != nil || !bytes.Equal(got, plain) {
+		t.Error("Unexpected opened packet ", got, err)
+	}
+	sealed[len(sealed)-1] ^= 1
+	if _, err := k2.open(sealed); err != decryptionError {
+		t.Error("Expected tampered packet to fail authentication, got ", err)
+	}
+	if _, err := k2.open(plain); err != badPacket {
+		t.Error("Expected plain packet to fail, got ", err)
+	}
+	sealed, _ = k2.seal(plain)
+	if _, err := k1.open(sealed); err != decryptionError {
+		t.Error("Expected unknown key to fail, got ", err)
+	}
+	// rotation on the first node
+	if err := k1.AddKey(key2); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := k1.open(sealed); err != nil || !bytes.Equal(got, plain) {
+		t.Error("Unexpected opened packet ", got, err)
+	}
+	if err := k1.RemoveKey(key1); err != primaryKey {
+		t.Error("Expected primary key error, got ", err)
+	}
+	if err := k1.UseKey(key2); err != nil {
+		t.Fatal(err)
+	}
+	if err := k1.RemoveKey(key1); err != nil {
+		t.Fatal(err)
+	}
+	if err := k1.UseKey(key1); err != unknownKey {
+		t.Error("Expected unknown key error, got ", err)
+	}
+	if keys := k1.Keys(); len(keys) != 1 || !bytes.Equal(keys[0], key2) {
+		t.Error("Unexpected keys ", keys)
+	}
+}
+
+func encryptedSwim(t *testing.T, keys ...[]byte) *Swim {
+	s, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	if len(keys) > 0 {
+		k, err := NewKeyring(keys...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.SetKeyring(k)
+	}
+	return s
+}
+
+func TestEncryption(t *testing.T) {
+	s1 := encryptedSwim(t, key1, key2)
+	defer s1.Close()
+	// rotation in progress: nodes send with different keys
+	s2 := encryptedSwim(t, key2, key1)
+	defer s2.Close()
+	plain := encryptedSwim(t)
+	defer plain.Close()
+	go s1.servePushPulls()
+	s2.AddHosts(s1.name)
+	plain.AddHosts(s1.name)
+
+	if msg := pingOnce(t, s1, s2, 1); msg.Ack == nil || !msg.Ack.Alive {
+		t.Error("Unexpected response ", msg)
+	}
+	if s1.nodesmap[s2.name].State() != gen.NodeState_ALIVE || s1.AuthFailures() != 0 || s2.AuthFailures() != 0 {
+		t.Error("Expected s2 to join s1")
+	}
+
+	// the packet is dropped without response
+	if err := plain.client.sendRequest(plain.nodesmap[s1.name].addr, &gen.SwimMessage{Seq: 1, Ping: &gen.Ping{SourceNode: plain.name}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s1.serveOnce(); err != nil {
+		t.Fatal(err)
+	}
+	plain.client.clientConn.SetDeadline(time.Now().Add(plain.config.ProbeTimeout))
+	if msg, err := plain.client.receiveResponse(1, &gen.SwimMessage{}); err == nil {
+		t.Error("Unexpected response ", msg)
+	}
+	if s1.AuthFailures() != 1 || s1.nodesmap[plain.name] != nil {
+		t.Error("Expected packet to fail authentication ", s1.AuthFailures())
+	}
+
+	if err := s2.pushPull(s2.nodesmap[s1.name]); err != nil {
+		t.Error("Unexpected push-pull error ", err)
+	}
+	if err := plain.pushPull(plain.nodesmap[s1.name]); err == nil {
+		t.Error("Expected push-pull to fail")
+	}
+	for i := 0; i < 100 && s1.AuthFailures() != 2; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	if s1.AuthFailures() != 2 {
+		t.Error("Expected push-pull to fail authentication ", s1.AuthFailures())
+	}
+}
@@ -0,0 +1,267 @@
+package swim
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"time"
+
+	"github.com/apesternikov/backplane/src/gen"
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+)
+
+// Anti-entropy: every update applied by the node is appended to the outbound log under the next local seq,
+// replacing the older entry about the same node, so the log holds the latest state of every known node.
+// Requests carry the last seq of the destination log seen by the source, responses carry log entries after it.
+// Requesters behind the oldest entry the log keeps, as well as a random node every pushPullPeriod,
+// exchange full state over TCP.
+
+// entries over this number are trimmed from the log, nodes behind them need push-pull
+var maxLogEntries = 1024
+
+// budget of log entries in a single packet, the rest is left for piggybacked updates
+var maxLogPacketSize = maxPacketSize / 2
+
+var pushPullPeriod = 30 * time.Second
+var pushPullTimeout = 2 * time.Second
+
+// limit of full state message size
+const maxPushPullSize = 16 << 20
+
+type logEntry struct {
+	seq    int64
+	update *gen.DisseminationUpdateMsg
+}
+
+// start sequence of a new log. Derived from the wall clock, so the log of a restarted node
+// is always ahead of seqs known by peers: they fetch the whole new log
+func logStart() int64 {
+	return now() / int64(time.Millisecond)
+}
+
+// append the update to the log replacing the entry about the same node
+// assumes s is locked
+func (s *Swim) appendLog(update *gen.DisseminationUpdateMsg) {
+	for i, e := range s.log {
+		if e.update.NodeName == update.NodeName {
+			copy(s.log[i:], s.log[i+1:])
+			s.log[len(s.log)-1] = nil
+			s.log = s.log[:len(s.log)-1]
+			break
+		}
+	}
+	s.logSeq++
+	s.log = append(s.log, &logEntry{seq: s.logSeq, update: update})
+	if len(s.log) > maxLogEntries {
+		s.logTrimmed = s.log[0].seq
+		s.log[0] = nil
+		s.log = s.log[1:]
+	}
+}
+
+// set known seq of the destination log in the request
+func (s *Swim) requestLog(msg *gen.SwimMessage, dest string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	msg.KnownDestSeq = 0
+	if n, ok := s.nodesmap[dest]; ok {
+		msg.KnownDestSeq = n.remoteSeq
+	}
+}
+
+// attach log entries after known seq to the response, as many as fit into maxLogPacketSize
+func (s *Swim) serveLog(msg *gen.SwimMessage, src string, known int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n, ok := s.nodesmap[src]; ok {
+		n.ackedSeq = known
+	}
+	msg.LogUpdates = nil
+	msg.SourceSeq = s.logSeq
+	msg.Behind = known < s.logTrimmed || known > s.logSeq
+	if msg.Behind {
+		known = 0
+	}
+	budget := maxLogPacketSize
+	for _, e := range s.log {
+		if e.seq <= known {
+			continue
+		}
+		size := fieldSize(e.update)
+		if size > budget {
+			msg.SourceSeq = e.seq - 1
+			break
+		}
+		msg.LogUpdates = append(msg.LogUpdates, e.update)
+		budget -= size
+	}
+}
+
+// apply log entries of the response and remember how far the source log is seen
+func (s *Swim) onLogResponse(src string, msg *gen.SwimMessage) {
+	s.onUpdatePkts(msg.LogUpdates)
+	if msg.SourceSeq == 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n, ok := s.nodesmap[src]
+	if !ok {
+		return
+	}
+	n.remoteSeq = msg.SourceSeq
+	if msg.Behind {
+		glog.V(1).Infof("Swim: behind the log of %s, requesting push-pull", src)
+		select {
+		case s.pushPullReq <- n:
+		default:
+		}
+	}
+}
+
+// full state of the node with the log seq it corresponds to
+// assumes s is locked
+func (s *Swim) fullState() *gen.SwimMessage {
+	msg := &gen.SwimMessage{SourceSeq: s.logSeq, LogUpdates: []*gen.DisseminationUpdateMsg{s.self}}
+	for _, n := range s.nodes {
+		if n.update != nil {
+			msg.LogUpdates = append(msg.LogUpdates, n.update)
+		}
+	}
+	return msg
+}
+
+func (s *Swim) writeMessage(w io.Writer, msg proto.Message) error {
+	bv, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if bv, err = s.seal(bv); err != nil {
+		return err
+	}
+	var l [4]byte
+	binary.BigEndian.PutUint32(l[:], uint32(len(bv)))
+	if _, err = w.Write(l[:]); err != nil {
+		return err
+	}
+	_, err = w.Write(bv)
+	return err
+}
+
+var tooBig = errors.New("push-pull message is too big")
+
+func (s *Swim) readMessage(r io.Reader, msg proto.Message, from string) error {
+	var l [4]byte
+	if _, err := io.ReadFull(r, l[:]); err != nil {
+		return err
+	}
+	size := binary.BigEndian.Uint32(l[:])
+	if size > maxPushPullSize+encryptionOverhead {
+		return tooBig
+	}
+	bv := make([]byte, size)
+	if _, err := io.ReadFull(r, bv); err != nil {
+		return err
+	}
+	bv, err := s.open(bv, from)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(bv, msg)
+}
+
+// exchange full state with the node over TCP
+func (s *Swim) pushPull(n *node) error {
+	conn, err := s.transport.DialStream(s.Addr, n.addr, pushPullTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	conn.SetDeadline(s.transport.Now().Add(pushPullTimeout))
+	s.mu.Lock()
+	local := s.fullState()
+	s.mu.Unlock()
+	local.Ping = &gen.Ping{SourceNode: s.name}
+	if err = s.writeMessage(conn, local); err != nil {
+		return err
+	}
+	var remote gen.SwimMessage
+	if err = s.readMessage(conn, &remote, conn.RemoteAddr().String()); err != nil {
+		return err
+	}
+	glog.V(1).Infof("Swim: push-pull with %s: sent %d received %d updates", n.name, len(local.LogUpdates), len(remote.LogUpdates))
+	s.onLogResponse(n.name, &remote)
+	return nil
+}
+
+// serve push-pull requests until the listener is closed
+func (s *Swim) servePushPulls() {
+	for {
+		conn, err := s.tcpListener.Accept()
+		if err != nil {
+			glog.V(1).Info("Swim: push-pull listener: ", err)
+			return
+		}
+		s.transport.Go(func() { s.servePushPull(conn) })
+	}
+}
+
+func (s *Swim) servePushPull(conn net.Conn) {
+	defer conn.Close()
+	conn.SetDeadline(s.transport.Now().Add(pushPullTimeout))
+	var remote gen.SwimMessage
+	if err := s.readMessage(conn, &remote, conn.RemoteAddr().String()); err != nil {
+		glog.Errorf("Swim: push-pull from %s: %s", conn.RemoteAddr(), err)
+		return
+	}
+	s.onUpdatePkts(remote.LogUpdates)
+	s.mu.Lock()
+	if remote.Ping != nil {
+		if src, ok := s.nodesmap[remote.Ping.SourceNode]; ok {
+			src.remoteSeq = remote.SourceSeq
+		}
+	}
+	local := s.fullState()
+	s.mu.Unlock()
+	if err := s.writeMessage(conn, local); err != nil {
+		glog.Errorf("Swim: push-pull to %s: %s", conn.RemoteAddr(), err)
+	}
+}
+
+// push-pull with a random live node every pushPullPeriod and with nodes we are behind of on request.
+// Requests are polled every protocol period, so the loop runs on the transport clock.
+func (s *Swim) pushPullLoop() {
+	rnd := newRand(s)
+	next := s.transport.Now().Add(pushPullPeriod)
+	for {
+		var n *node
+		select {
+		case <-s.closed:
+			return
+		case n = <-s.pushPullReq:
+		default:
+			if s.transport.Now().Before(next) {
+				s.transport.Sleep(s.config.ProbeInterval)
+				continue
+			}
+			next = s.transport.Now().Add(pushPullPeriod)
+			s.mu.Lock()
+			var up []*node
+			for _, n := range s.nodes {
+				if n.Up() {
+					up = append(up, n)
+				}
+			}
+			s.mu.Unlock()
+			if len(up) == 0 {
+				continue
+			}
+			n = up[rnd.Intn(len(up))]
+		}
+		if err := s.pushPull(n); err != nil {
+			glog.Errorf("Swim: push-pull with %s: %s", n.name, err)
+		}
+	}
+}
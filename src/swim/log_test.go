@@ -0,0 +1,152 @@
+package swim
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apesternikov/backplane/src/gen"
+)
+
+func remoteNodes(n int) []*gen.DisseminationUpdateMsg {
+	var updates []*gen.DisseminationUpdateMsg
+	for i := 0; i < n; i++ {
+		name := fmt.Sprintf("10.0.%d.%d:7946", i/256, i%256)
+		updates = append(updates, newUpdate(name, name, gen.NodeState_ALIVE, 0))
+	}
+	return updates
+}
+
+func TestLogCompaction(t *testing.T) {
+	s, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s.Close()
+	start := s.logSeq
+	updates := remoteNodes(2)
+	s.onUpdatePkts(updates)
+	s.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate(updates[0].NodeName, "", gen.NodeState_SUSPECT, 0)})
+	if s.logSeq != start+3 || len(s.log) != 3 {
+		t.Fatal("Unexpected log ", s.logSeq-start, len(s.log))
+	}
+	// self, second node, suspected first node
+	if s.log[0].update != s.self || s.log[1].update != updates[1] || s.log[2].update.State != gen.NodeState_SUSPECT || s.log[2].seq != s.logSeq {
+		t.Error("Unexpected log entries ", s.log[0], s.log[1], s.log[2])
+	}
+	var msg gen.SwimMessage
+	s.serveLog(&msg, "", s.logSeq-1)
+	if len(msg.LogUpdates) != 1 || msg.LogUpdates[0].State != gen.NodeState_SUSPECT || msg.SourceSeq != s.logSeq || msg.Behind {
+		t.Error("Unexpected response ", &msg)
+	}
+	s.serveLog(&msg, "", s.logSeq)
+	if len(msg.LogUpdates) != 0 || msg.SourceSeq != s.logSeq || msg.Behind {
+		t.Error("Unexpected response ", &msg)
+	}
+	// unknown seq
+	s.serveLog(&msg, "", s.logSeq+1)
+	if len(msg.LogUpdates) != 3 || !msg.Behind {
+		t.Error("Unexpected response ", &msg)
+	}
+}
+
+// ping s1 from s2 and return the response
+func pingOnce(t *testing.T, s1, s2 *Swim, seq int64) *gen.SwimMessage {
+	err := s2.client.sendRequest(s2.nodesmap[s1.name].addr, &gen.SwimMessage{Seq: seq, Ping: &gen.Ping{SourceNode: s2.name}})
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+	s1.serveOnce()
+	msg, err := s2.client.receiveResponse(seq, &gen.SwimMessage{})
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+	return msg
+}
+
+func TestLogCatchUp(t *testing.T) {
+	s1, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s1.Close()
+	s2, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s2.Close()
+	s2.AddHosts(s1.name)
+	s1.onUpdatePkts(remoteNodes(100))
+	// gossip is missed
+	s1.queue = nil
+	var seq int64
+	for seq = 1; seq < 20 && len(s2.nodes) < 101; seq++ {
+		msg := pingOnce(t, s1, s2, seq)
+		if len(msg.LogUpdates) == 0 || msg.Behind {
+			t.Fatal("Unexpected response ", msg)
+		}
+	}
+	if len(s2.nodes) != 101 {
+		t.Fatal("Expected s2 to catch up, got nodes ", len(s2.nodes))
+	}
+	s1.mu.Lock()
+	head := s1.logSeq
+	s1.mu.Unlock()
+	if n := s2.nodesmap[s1.name]; n.remoteSeq != head || n.State() != gen.NodeState_ALIVE {
+		t.Error("Unexpected s1 state on s2 ", n, n.remoteSeq, head)
+	}
+	// s1 learns how far s2 got with the next request
+	msg := pingOnce(t, s1, s2, seq)
+	if len(msg.LogUpdates) != 0 || msg.SourceSeq != head {
+		t.Error("Expected no log entries in the response ", msg)
+	}
+	if n := s1.nodesmap[s2.name]; n.ackedSeq != head {
+		t.Error("Unexpected acked seq ", n.ackedSeq, head)
+	}
+}
+
+func TestPushPull(t *testing.T) {
+	defer func(n int) { maxLogEntries = n }(maxLogEntries)
+	maxLogEntries = 10
+	s1, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s1.Close()
+	s2, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s2.Close()
+	go s1.servePushPulls()
+	s2.AddHosts(s1.name)
+	s1.onUpdatePkts(remoteNodes(20))
+	s2.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate("10.1.0.1:7946", "10.1.0.1:7946", gen.NodeState_ALIVE, 0)})
+
+	if msg := pingOnce(t, s1, s2, 1); !msg.Behind {
+		t.Fatal("Expected s2 to be behind the log ", msg)
+	}
+	var n *node
+	select {
+	case n = <-s2.pushPullReq:
+	default:
+		t.Fatal("Expected push-pull to be requested")
+	}
+	pushed := s2.logSeq
+	if err := s2.pushPull(n); err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+	// s1, its 20 nodes and 10.1.0.1
+	if len(s2.nodes) != 22 {
+		t.Error("Unexpected nodes on s2 ", len(s2.nodes))
+	}
+	s1.mu.Lock()
+	defer s1.mu.Unlock()
+	// s2 and its node
+	if len(s1.nodes) != 22 || s1.nodesmap["10.1.0.1:7946"] == nil {
+		t.Error("Unexpected nodes on s1 ", len(s1.nodes))
+	}
+	// the full state of s2 corresponds to its log before it applied the state of s1
+	if s1.nodesmap[s2.name].remoteSeq != pushed || s2.nodesmap[s1.name].remoteSeq != s1.logSeq {
+		t.Error("Unexpected seqs after push-pull")
+	}
+}
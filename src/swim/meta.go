@@ -0,0 +1,81 @@
+package swim
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/apesternikov/backplane/src/gen"
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+)
+
+// Well known metadata keys
+const (
+	MetaDatacenter = "dc"
+	MetaRole       = "role"
+	MetaHttpAddr   = "http"     // host:port of the frontend
+	MetaVersion    = "version"  // build version
+	MetaBackends   = "backends" // comma separated names of backends served by the node
+)
+
+// limit of encoded metadata size, it is attached to every update about the node
+var maxMetaSize = 512
+
+var metaTooBig = errors.New("metadata is too big")
+
+func metaSize(meta map[string]string) int {
+	return proto.Size(&gen.DisseminationUpdateMsg{Meta: meta})
+}
+
+func metaEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
+
+func copyMeta(meta map[string]string) map[string]string {
+	if meta == nil {
+		return nil
+	}
+	c := make(map[string]string, len(meta))
+	for k, v := range meta {
+		c[k] = v
+	}
+	return c
+}
+
+// format metadata as sorted k=v list
+func formatMeta(meta map[string]string) string {
+	kv := make([]string, 0, len(meta))
+	for k, v := range meta {
+		kv = append(kv, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(kv)
+	return strings.Join(kv, " ")
+}
+
+// SetMeta publishes metadata of this node replacing the previous one.
+// The incarnation is bumped, so the new metadata takes precedence over the old one.
+func (s *Swim) SetMeta(meta map[string]string) error {
+	if size := metaSize(meta); size > maxMetaSize {
+		glog.Errorf("Swim: metadata size %d exceeds %d", size, maxMetaSize)
+		return metaTooBig
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if metaEqual(meta, s.self.Meta) {
+		return nil
+	}
+	s.self = newUpdate(s.name, s.name, s.self.State, s.clock.IncrementEpoch())
+	s.self.Meta = copyMeta(meta)
+	s.broadcast(s.self)
+	return nil
+}
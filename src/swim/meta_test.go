@@ -0,0 +1,59 @@
+package swim
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apesternikov/backplane/src/gen"
+)
+
+func TestMeta(t *testing.T) {
+	s1, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s1.Close()
+	s2, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s2.Close()
+	meta := map[string]string{MetaDatacenter: "dc1", MetaRole: "frontend", MetaHttpAddr: "10.0.0.1:80"}
+	if err := s1.SetMeta(meta); err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+	meta[MetaRole] = "changed"
+	if m, _ := s1.Member(s1.name); m.Incarnation != 1 || m.Meta[MetaRole] != "frontend" {
+		t.Error("Unexpected self ", m)
+	}
+	if err := s1.SetMeta(map[string]string{MetaBackends: strings.Repeat("b", maxMetaSize)}); err != metaTooBig {
+		t.Error("Expected metadata to be too big, got ", err)
+	}
+
+	s2.AddHosts(s1.name)
+	pingOnce(t, s1, s2, 1)
+	m, ok := s2.Member(s1.name)
+	if !ok || m.Incarnation != 1 || len(m.Meta) != 3 || m.Meta[MetaDatacenter] != "dc1" {
+		t.Fatal("Expected metadata to be disseminated ", m)
+	}
+	// other nodes keep metadata changing the state
+	s2.mu.Lock()
+	s2.nodesmap[s1.name].suspect(s2.name)
+	s2.mu.Unlock()
+	if m, _ := s2.Member(s1.name); m.State != gen.NodeState_SUSPECT || m.Meta[MetaDatacenter] != "dc1" {
+		t.Error("Expected metadata to be kept ", m)
+	}
+	// metadata announced before restart is refuted
+	stale := newUpdate(s1.name, s1.name, gen.NodeState_ALIVE, 1)
+	stale.Meta = map[string]string{MetaVersion: "1.0"}
+	s1.onUpdatePkts([]*gen.DisseminationUpdateMsg{stale})
+	if m, _ := s1.Member(s1.name); m.Incarnation != 2 || len(m.Meta) != 3 {
+		t.Error("Expected stale metadata to be refuted ", m)
+	}
+	// oversized metadata is ignored
+	big := newUpdate("10.0.0.1:7946", "10.0.0.1:7946", gen.NodeState_ALIVE, 0)
+	big.Meta = map[string]string{MetaBackends: strings.Repeat("b", maxMetaSize)}
+	if s2.onUpdatePkts([]*gen.DisseminationUpdateMsg{big}) {
+		t.Error("Expected update with oversized metadata to be ignored")
+	}
+}
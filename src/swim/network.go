@@ -0,0 +1,564 @@
+package swim
+
+import (
+	"container/heap"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+)
+
+// Simulated network: Network is an in-memory Transport for tests. Packets are delayed by the latency
+// plus random jitter, which reorders them, and dropped with the loss probability or between partitions.
+// Streams are reliable and ordered, but can not be dialed across partitions.
+//
+// Time is simulated and stands still between runs. Run advances it to the next event (packet delivery,
+// timeout or end of sleep) whenever every goroutine started with Go is blocked on the network,
+// and wakes goroutines one at a time in the order of their events. The protocol runs much faster
+// than in real time, and a simulation with the same seed runs the same way regardless of the machine.
+// Blocking calls are allowed only in goroutines started with Go, other goroutines may inspect nodes
+// and change the network between runs.
+
+var (
+	errNetClosed  = errors.New("use of closed network connection")
+	errRefused    = errors.New("connection refused")
+	errAddrInUse  = errors.New("address already in use")
+	errNotRunning = errors.New("simulation is not running")
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+type event struct {
+	at    time.Time
+	seq   int64 //orders events of the same time
+	fire  func()
+	index int //in the heap, -1 when fired or cancelled
+}
+
+type eventHeap []*event
+
+func (h eventHeap) Len() int { return len(h) }
+func (h eventHeap) Less(i, j int) bool {
+	if h[i].at.Equal(h[j].at) {
+		return h[i].seq < h[j].seq
+	}
+	return h[i].at.Before(h[j].at)
+}
+func (h eventHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+func (h *eventHeap) Push(x interface{}) {
+	e := x.(*event)
+	e.index = len(*h)
+	*h = append(*h, e)
+}
+func (h *eventHeap) Pop() interface{} {
+	old := *h
+	e := old[len(old)-1]
+	e.index = -1
+	*h = old[:len(old)-1]
+	return e
+}
+
+// goroutine blocked on the network
+type waiter struct {
+	wake    chan struct{}
+	timeout *event
+}
+
+// Network connects nodes created with it as their transport
+type Network struct {
+	mu      sync.Mutex
+	rand    *rand.Rand
+	now     time.Time
+	seq     int64
+	events  eventHeap
+	running int           //goroutines started with Go and not blocked on the network
+	until   time.Time     //end of the current run
+	done    chan struct{} //closed at the end of the run, nil between runs
+
+	latency, jitter time.Duration
+	loss            float64
+	groups          map[string]int //partition of hosts, hosts in different groups can not talk
+	ngroups         int
+
+	packets   map[string]*memPacketConn
+	listeners map[string]*memListener
+	nextPort  int
+}
+
+// NewNetwork creates the simulated network, seed determines random latencies and losses
+func NewNetwork(seed int64) *Network {
+	return &Network{
+		rand:      rand.New(rand.NewSource(seed)),
+		now:       time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+		groups:    make(map[string]int),
+		packets:   make(map[string]*memPacketConn),
+		listeners: make(map[string]*memListener),
+		nextPort:  32768,
+	}
+}
+
+// SetLatency delays packets by latency plus random jitter up to jitter
+func (n *Network) SetLatency(latency, jitter time.Duration) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.latency, n.jitter = latency, jitter
+}
+
+// SetLoss drops packets with probability p
+func (n *Network) SetLoss(p float64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.loss = p
+}
+
+// Partition isolates hosts (ip or ip:port) from the rest of the network, they only talk among themselves
+func (n *Network) Partition(hosts ...string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.ngroups++
+	for _, h := range hosts {
+		n.groups[hostOf(h)] = n.ngroups
+	}
+}
+
+// Heal removes all partitions
+func (n *Network) Heal() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.groups = make(map[string]int)
+}
+
+func hostOf(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// assumes n is locked
+func (n *Network) partitioned(a, b string) bool {
+	return n.groups[hostOf(a)] != n.groups[hostOf(b)]
+}
+
+// Run runs the simulation for d of simulated time
+func (n *Network) Run(d time.Duration) {
+	n.mu.Lock()
+	done := make(chan struct{})
+	n.until = n.now.Add(d)
+	n.done = done
+	n.advance()
+	n.mu.Unlock()
+	<-done
+}
+
+// fire events until a goroutine wakes up or the run is over
+// assumes n is locked
+func (n *Network) advance() {
+	for n.running == 0 && n.done != nil {
+		if len(n.events) == 0 || n.events[0].at.After(n.until) {
+			n.now = n.until
+			close(n.done)
+			n.done = nil
+			return
+		}
+		e := heap.Pop(&n.events).(*event)
+		n.now = e.at
+		e.fire()
+	}
+}
+
+// assumes n is locked
+func (n *Network) schedule(d time.Duration, f func()) *event {
+	if d < 0 {
+		d = 0
+	}
+	n.seq++
+	e := &event{at: n.now.Add(d), seq: n.seq, fire: f}
+	heap.Push(&n.events, e)
+	return e
+}
+
+// assumes n is locked
+func (n *Network) cancel(e *event) {
+	if e != nil && e.index >= 0 {
+		heap.Remove(&n.events, e.index)
+	}
+}
+
+// block the calling goroutine until woken by an event
+// assumes n is locked, returns locked
+func (n *Network) block(w *waiter) {
+	n.running--
+	n.advance()
+	n.mu.Unlock()
+	<-w.wake
+	n.mu.Lock()
+}
+
+// assumes n is locked
+func (n *Network) wake(w *waiter) {
+	n.cancel(w.timeout)
+	n.running++
+	close(w.wake)
+}
+
+// wake waiters at the current time, one by one. Used outside of events not to run goroutines concurrently
+// assumes n is locked
+func (n *Network) wakeLater(waiters []*waiter) {
+	for _, w := range waiters {
+		w := w
+		n.cancel(w.timeout)
+		n.schedule(0, func() { n.wake(w) })
+	}
+}
+
+// add a waiter timing out at deadline, if set
+// assumes n is locked
+func (n *Network) newWaiter(deadline time.Time, remove func(*waiter)) *waiter {
+	w := &waiter{wake: make(chan struct{})}
+	if !deadline.IsZero() {
+		w.timeout = n.schedule(deadline.Sub(n.now), func() {
+			remove(w)
+			n.wake(w)
+		})
+	}
+	return w
+}
+
+func removeWaiter(waiters []*waiter, w *waiter) []*waiter {
+	for i := range waiters {
+		if waiters[i] == w {
+			return append(waiters[:i], waiters[i+1:]...)
+		}
+	}
+	return waiters
+}
+
+// wake the first waiter
+// assumes n is locked
+func (n *Network) wakeFirst(waiters []*waiter) []*waiter {
+	if len(waiters) == 0 {
+		return waiters
+	}
+	n.wake(waiters[0])
+	return waiters[1:]
+}
+
+func (n *Network) Now() time.Time {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.now
+}
+
+func (n *Network) Sleep(d time.Duration) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	w := &waiter{wake: make(chan struct{})}
+	n.schedule(d, func() { n.wake(w) })
+	n.block(w)
+}
+
+// Go starts f taking part in the simulation
+func (n *Network) Go(f func()) {
+	n.mu.Lock()
+	w := &waiter{wake: make(chan struct{})}
+	n.schedule(0, func() { n.wake(w) })
+	n.mu.Unlock()
+	go func() {
+		<-w.wake
+		f()
+		n.mu.Lock()
+		n.running--
+		n.advance()
+		n.mu.Unlock()
+	}()
+}
+
+// assumes n is locked
+func (n *Network) freePort(ip net.IP) int {
+	for {
+		n.nextPort++
+		if n.packets[(&net.UDPAddr{IP: ip, Port: n.nextPort}).String()] == nil {
+			return n.nextPort
+		}
+	}
+}
+
+func (n *Network) ListenPacket(addr *net.UDPAddr) (net.PacketConn, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	local := &net.UDPAddr{IP: addr.IP, Port: addr.Port}
+	if local.Port == 0 {
+		local.Port = n.freePort(local.IP)
+	}
+	if n.packets[local.String()] != nil {
+		return nil, &net.OpError{Op: "listen", Net: "udp", Addr: local, Err: errAddrInUse}
+	}
+	c := &memPacketConn{net: n, addr: local}
+	n.packets[local.String()] = c
+	return c, nil
+}
+
+func (n *Network) ListenStream(addr *net.UDPAddr) (net.Listener, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	local := &net.TCPAddr{IP: addr.IP, Port: addr.Port}
+	if n.listeners[local.String()] != nil {
+		return nil, &net.OpError{Op: "listen", Net: "tcp", Addr: local, Err: errAddrInUse}
+	}
+	l := &memListener{net: n, addr: local}
+	n.listeners[local.String()] = l
+	return l, nil
+}
+
+func (n *Network) DialStream(local, remote *net.UDPAddr, timeout time.Duration) (net.Conn, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	raddr := &net.TCPAddr{IP: remote.IP, Port: remote.Port}
+	l := n.listeners[raddr.String()]
+	if l == nil || n.partitioned(local.String(), remote.String()) {
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Addr: raddr, Err: errRefused}
+	}
+	laddr := &net.TCPAddr{IP: local.IP, Port: n.freePort(local.IP)}
+	c := &memStream{net: n, local: laddr, remote: raddr}
+	s := &memStream{net: n, local: raddr, remote: laddr, peer: c}
+	c.peer = s
+	n.schedule(n.latency, func() {
+		if l.closed {
+			s.Close()
+			return
+		}
+		l.queue = append(l.queue, s)
+		l.waiters = n.wakeFirst(l.waiters)
+	})
+	return c, nil
+}
+
+type memPacket struct {
+	data []byte
+	from *net.UDPAddr
+}
+
+type memPacketConn struct {
+	net      *Network
+	addr     *net.UDPAddr
+	queue    []memPacket
+	waiters  []*waiter
+	deadline time.Time
+	closed   bool
+}
+
+func (c *memPacketConn) ReadFrom(b []byte) (int, net.Addr, error) {
+	n := c.net
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for {
+		switch {
+		case c.closed:
+			return 0, nil, &net.OpError{Op: "read", Net: "udp", Addr: c.addr, Err: errNetClosed}
+		case len(c.queue) > 0:
+			p := c.queue[0]
+			c.queue = c.queue[1:]
+			return copy(b, p.data), p.from, nil
+		case !c.deadline.IsZero() && !n.now.Before(c.deadline):
+			return 0, nil, &net.OpError{Op: "read", Net: "udp", Addr: c.addr, Err: timeoutError{}}
+		case n.done == nil && n.running == 0:
+			return 0, nil, &net.OpError{Op: "read", Net: "udp", Addr: c.addr, Err: errNotRunning}
+		}
+		w := n.newWaiter(c.deadline, func(w *waiter) { c.waiters = removeWaiter(c.waiters, w) })
+		c.waiters = append(c.waiters, w)
+		n.block(w)
+	}
+}
+
+func (c *memPacketConn) WriteTo(b []byte, addr net.Addr) (int, error) {
+	n := c.net
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if c.closed {
+		return 0, &net.OpError{Op: "write", Net: "udp", Addr: c.addr, Err: errNetClosed}
+	}
+	to := addr.String()
+	if n.partitioned(c.addr.String(), to) || n.loss > 0 && n.rand.Float64() < n.loss {
+		return len(b), nil
+	}
+	delay := n.latency
+	if n.jitter > 0 {
+		delay += time.Duration(n.rand.Int63n(int64(n.jitter)))
+	}
+	p := memPacket{data: append([]byte(nil), b...), from: c.addr}
+	n.schedule(delay, func() {
+		if dst := n.packets[to]; dst != nil {
+			dst.queue = append(dst.queue, p)
+			dst.waiters = n.wakeFirst(dst.waiters)
+		}
+	})
+	return len(b), nil
+}
+
+func (c *memPacketConn) Close() error {
+	n := c.net
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if c.closed {
+		return &net.OpError{Op: "close", Net: "udp", Addr: c.addr, Err: errNetClosed}
+	}
+	c.closed = true
+	delete(n.packets, c.addr.String())
+	n.wakeLater(c.waiters)
+	c.waiters = nil
+	return nil
+}
+
+func (c *memPacketConn) LocalAddr() net.Addr { return c.addr }
+
+func (c *memPacketConn) SetDeadline(t time.Time) error {
+	c.net.mu.Lock()
+	defer c.net.mu.Unlock()
+	c.deadline = t
+	return nil
+}
+
+func (c *memPacketConn) SetReadDeadline(t time.Time) error  { return c.SetDeadline(t) }
+func (c *memPacketConn) SetWriteDeadline(t time.Time) error { return nil }
+
+type memListener struct {
+	net     *Network
+	addr    *net.TCPAddr
+	queue   []*memStream
+	waiters []*waiter
+	closed  bool
+}
+
+func (l *memListener) Accept() (net.Conn, error) {
+	n := l.net
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for {
+		switch {
+		case l.closed:
+			return nil, &net.OpError{Op: "accept", Net: "tcp", Addr: l.addr, Err: errNetClosed}
+		case len(l.queue) > 0:
+			s := l.queue[0]
+			l.queue = l.queue[1:]
+			return s, nil
+		case n.done == nil && n.running == 0:
+			return nil, &net.OpError{Op: "accept", Net: "tcp", Addr: l.addr, Err: errNotRunning}
+		}
+		w := n.newWaiter(time.Time{}, nil)
+		l.waiters = append(l.waiters, w)
+		n.block(w)
+	}
+}
+
+func (l *memListener) Close() error {
+	n := l.net
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if l.closed {
+		return &net.OpError{Op: "close", Net: "tcp", Addr: l.addr, Err: errNetClosed}
+	}
+	l.closed = true
+	delete(n.listeners, l.addr.String())
+	n.wakeLater(l.waiters)
+	l.waiters = nil
+	return nil
+}
+
+func (l *memListener) Addr() net.Addr { return l.addr }
+
+type memStream struct {
+	net           *Network
+	local, remote *net.TCPAddr
+	peer          *memStream
+	buf           []byte
+	eof           bool //closed by the peer
+	closed        bool
+	waiters       []*waiter
+	deadline      time.Time
+}
+
+func (s *memStream) Read(b []byte) (int, error) {
+	n := s.net
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for {
+		switch {
+		case s.closed:
+			return 0, &net.OpError{Op: "read", Net: "tcp", Addr: s.local, Err: errNetClosed}
+		case len(s.buf) > 0:
+			l := copy(b, s.buf)
+			s.buf = s.buf[l:]
+			return l, nil
+		case s.eof:
+			return 0, io.EOF
+		case !s.deadline.IsZero() && !n.now.Before(s.deadline):
+			return 0, &net.OpError{Op: "read", Net: "tcp", Addr: s.local, Err: timeoutError{}}
+		case n.done == nil && n.running == 0:
+			return 0, &net.OpError{Op: "read", Net: "tcp", Addr: s.local, Err: errNotRunning}
+		}
+		w := n.newWaiter(s.deadline, func(w *waiter) { s.waiters = removeWaiter(s.waiters, w) })
+		s.waiters = append(s.waiters, w)
+		n.block(w)
+	}
+}
+
+func (s *memStream) Write(b []byte) (int, error) {
+	n := s.net
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if s.closed {
+		return 0, &net.OpError{Op: "write", Net: "tcp", Addr: s.local, Err: errNetClosed}
+	}
+	data := append([]byte(nil), b...)
+	peer := s.peer
+	n.schedule(n.latency, func() {
+		if !peer.closed {
+			peer.buf = append(peer.buf, data...)
+			peer.waiters = n.wakeFirst(peer.waiters)
+		}
+	})
+	return len(b), nil
+}
+
+func (s *memStream) Close() error {
+	n := s.net
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if s.closed {
+		return &net.OpError{Op: "close", Net: "tcp", Addr: s.local, Err: errNetClosed}
+	}
+	s.closed = true
+	n.wakeLater(s.waiters)
+	s.waiters = nil
+	peer := s.peer
+	n.schedule(n.latency, func() {
+		peer.eof = true
+		n.wakeLater(peer.waiters)
+		peer.waiters = nil
+	})
+	return nil
+}
+
+func (s *memStream) LocalAddr() net.Addr  { return s.local }
+func (s *memStream) RemoteAddr() net.Addr { return s.remote }
+
+func (s *memStream) SetDeadline(t time.Time) error {
+	s.net.mu.Lock()
+	defer s.net.mu.Unlock()
+	s.deadline = t
+	return nil
+}
+
+func (s *memStream) SetReadDeadline(t time.Time) error  { return s.SetDeadline(t) }
+func (s *memStream) SetWriteDeadline(t time.Time) error { return nil }
@@ -0,0 +1,158 @@
+package swim
+
+import (
+	"io"
+	"io/ioutil"
+	"net"
+	"sort"
+	"testing"
+	"time"
+)
+
+func listenPacket(t *testing.T, n *Network, hostport string) net.PacketConn {
+	addr, err := parseIpPort(hostport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := n.ListenPacket(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// send count packets numbered from 0 from a to b, and collect packets received by b until it times out
+func exchange(n *Network, a, b net.PacketConn, count int) (received []int, last time.Duration, err error) {
+	start := n.Now()
+	n.Go(func() {
+		for i := 0; i < count; i++ {
+			a.WriteTo([]byte{byte(i), byte(i >> 8)}, b.LocalAddr())
+		}
+	})
+	n.Go(func() {
+		var buf [2]byte
+		b.SetDeadline(start.Add(time.Second))
+		for {
+			if _, _, err = b.ReadFrom(buf[:]); err != nil {
+				return
+			}
+			received = append(received, int(buf[0])|int(buf[1])<<8)
+			last = n.Now().Sub(start)
+		}
+	})
+	n.Run(2 * time.Second)
+	return
+}
+
+func TestNetworkPackets(t *testing.T) {
+	n := NewNetwork(1)
+	a := listenPacket(t, n, "10.0.0.1:1")
+	b := listenPacket(t, n, "10.0.0.2:1")
+	if _, err := n.ListenPacket(b.LocalAddr().(*net.UDPAddr)); err == nil {
+		t.Error("Expected address in use error")
+	}
+	start := n.Now()
+	n.SetLatency(10*time.Millisecond, 0)
+	received, last, err := exchange(n, a, b, 3)
+	if len(received) != 3 || received[0] != 0 || received[2] != 2 || last != 10*time.Millisecond {
+		t.Error("Unexpected packets ", received, last)
+	}
+	if e, ok := err.(net.Error); !ok || !e.Timeout() {
+		t.Error("Expected timeout, got ", err)
+	}
+	if d := n.Now().Sub(start); d != 2*time.Second {
+		t.Error("Expected simulated time to pass, got ", d)
+	}
+
+	// jitter reorders packets
+	n.SetLatency(0, 100*time.Millisecond)
+	received, _, _ = exchange(n, a, b, 100)
+	if len(received) != 100 || sort.IntsAreSorted(received) {
+		t.Error("Expected all packets reordered ", received)
+	}
+
+	n.SetLatency(time.Millisecond, 0)
+	n.SetLoss(0.5)
+	received, _, _ = exchange(n, a, b, 1000)
+	if len(received) < 400 || len(received) > 600 {
+		t.Error("Expected half of packets lost, received ", len(received))
+	}
+
+	n.SetLoss(0)
+	n.Partition("10.0.0.2")
+	if received, _, _ = exchange(n, a, b, 10); len(received) != 0 {
+		t.Error("Expected packets dropped between partitions ", received)
+	}
+	n.Heal()
+	if received, _, _ = exchange(n, a, b, 10); len(received) != 10 {
+		t.Error("Expected packets after heal ", received)
+	}
+
+	// close wakes the reader
+	var closeErr error
+	n.Go(func() {
+		b.SetDeadline(time.Time{})
+		_, _, closeErr = b.ReadFrom(make([]byte, 1))
+	})
+	n.Run(time.Second)
+	b.Close()
+	n.Run(time.Second)
+	if closeErr == nil {
+		t.Error("Expected read from closed connection to fail")
+	}
+}
+
+func TestNetworkStreams(t *testing.T) {
+	n := NewNetwork(1)
+	n.SetLatency(10*time.Millisecond, 0)
+	a, _ := parseIpPort("10.0.0.1:1")
+	b, _ := parseIpPort("10.0.0.2:1")
+	l, err := n.ListenStream(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	n.Go(func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			n.Go(func() {
+				defer conn.Close()
+				var buf [5]byte
+				if _, err := io.ReadFull(conn, buf[:]); err == nil {
+					conn.Write(buf[:])
+				}
+			})
+		}
+	})
+	var echo []byte
+	var took time.Duration
+	dial := func() (err error) {
+		n.Go(func() {
+			var conn net.Conn
+			if conn, err = n.DialStream(a, b, time.Second); err != nil {
+				return
+			}
+			defer conn.Close()
+			start := n.Now()
+			conn.Write([]byte("hello"))
+			echo, err = ioutil.ReadAll(conn)
+			took = n.Now().Sub(start)
+		})
+		n.Run(time.Second)
+		return
+	}
+	if err := dial(); err != nil || string(echo) != "hello" || took != 20*time.Millisecond {
+		t.Error("Unexpected echo ", string(echo), took, err)
+	}
+	n.Partition("10.0.0.1")
+	if err := dial(); err == nil {
+		t.Error("Expected dial between partitions to fail")
+	}
+	n.Heal()
+	if err := dial(); err != nil {
+		t.Error("Unexpected error after heal ", err)
+	}
+}
@@ -3,9 +3,9 @@ package swim
 import (
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"math/rand"
 	"net"
-	"time"
 
 	"github.com/apesternikov/backplane/src/gen"
 	"github.com/golang/glog"
@@ -16,16 +16,17 @@ import (
 // We will have at least two (local and wan)
 type swimmer struct {
 	s          *Swim
-	clientConn *net.UDPConn
+	clientConn net.PacketConn
+	rand       *rand.Rand //not shared between swimmers, so simulations are repeatable
 	seq        int64
 	buf        [1500]byte
 	pb         gen.SwimMessage
 }
 
 func newSwimmer(s *Swim) (ret *swimmer, err error) {
-	ret = &swimmer{s: s}
+	ret = &swimmer{s: s, rand: newRand(s)}
 	cliAddr := net.UDPAddr{IP: s.Addr.IP, Port: 0}
-	ret.clientConn, err = net.ListenUDP(cliAddr.Network(), &cliAddr)
+	ret.clientConn, err = s.transport.ListenPacket(&cliAddr)
 	if err != nil {
 		glog.Errorf("Swim: Unable to listen on client udp %s: %s", &cliAddr, err)
 		return nil, err
@@ -40,42 +41,91 @@ func (s *swimmer) close() {
 	}
 }
 
+// random source seeded by the time and the node name: nodes started at the same simulated time differ
+func newRand(s *Swim) *rand.Rand {
+	h := fnv.New64a()
+	h.Write([]byte(s.Addr.String()))
+	return rand.New(rand.NewSource(s.transport.Now().UnixNano() ^ int64(h.Sum64())))
+}
+
 //shuffle nodes list using Satollo's Fisher-Yates
 //http://en.wikipedia.org/wiki/Fisher%E2%80%93Yates_shuffle#Sattolo.27s_algorithm
-func shuffle(a []*node) {
+func shuffle(a []*node, rnd *rand.Rand) {
 	for i := range a {
-		j := rand.Intn(i + 1)
+		j := rnd.Intn(i + 1)
 		a[i], a[j] = a[j], a[i]
 	}
 }
 
 var noShuffleForTest = false
 
-func (s *swimmer) protoLoop() {
+//probe one node every protocol period, round-robin over the shuffled list of nodes.
+//The list is shuffled again with nodes joined since after every round.
+//Probes run in parallel, so a slow probe does not delay the next one
+func (s *Swim) protoLoop() {
+	t := s.transport
+	rnd := newRand(s)
+	var order []*node
 	for {
-		glog.V(4).Info("running protoCycle")
-		c := time.After(protoPreiod)
-		s.protoCycle()
-		<-c
+		select {
+		case <-s.closed:
+			return
+		default:
+		}
+		next := t.Now().Add(s.config.ProbeInterval)
+		s.expireSuspicions()
+		if len(order) == 0 {
+			s.mu.Lock()
+			order = append(order, s.nodes...)
+			s.mu.Unlock()
+			if !noShuffleForTest {
+				shuffle(order, rnd)
+			}
+		}
+		for len(order) > 0 {
+			target := order[0]
+			order = order[1:]
+			if target.name != s.name {
+				glog.V(4).Infof("Swim: probing %s", target.name)
+				t.Go(func() { s.probe(target) })
+				break
+			}
+		}
+		t.Sleep(next.Sub(t.Now()))
 	}
 }
 
-//select random peer and perform communication with it
-func (s *swimmer) protoCycle() {
-	//select all nodes and randomize the list
-	s.s.mu.Lock()
-	var nodes []*node = make([]*node, len(s.s.nodes))
-	copy(nodes, s.s.nodes)
-	s.s.mu.Unlock()
-	if !noShuffleForTest {
-		shuffle(nodes)
-	}
-	for _, n := range nodes {
-		if n.name != s.s.name {
-			c := time.After(rtt * 3)
-			s.protoOnce(n)
-			<-c
-		}
+func (s *Swim) probe(target *node) {
+	c, err := s.getProber()
+	if err != nil {
+		glog.Errorf("Swim: unable to probe %s: %s", target.name, err)
+		return
+	}
+	defer s.putProber(c)
+	c.protoOnce(target)
+}
+
+//client of a probe, probes running in parallel need their own sockets
+func (s *Swim) getProber() (*swimmer, error) {
+	s.mu.Lock()
+	if l := len(s.probers); l > 0 {
+		c := s.probers[l-1]
+		s.probers = s.probers[:l-1]
+		s.mu.Unlock()
+		return c, nil
+	}
+	s.mu.Unlock()
+	return newSwimmer(s)
+}
+
+func (s *Swim) putProber(c *swimmer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-s.closed:
+		c.close()
+	default:
+		s.probers = append(s.probers, c)
 	}
 }
 
@@ -89,53 +139,71 @@ func (s *swimmer) protoOnce(target *node) {
 		s.s.mu.Lock()
 		upnodes := make([]*node, 0, len(s.s.nodes))
 		for _, n := range s.s.nodes {
-			if n != target && n.Up() {
+			if n != target && n.State() == gen.NodeState_ALIVE {
 				upnodes = append(upnodes, n)
 			}
 		}
 		s.s.mu.Unlock()
-		if len(upnodes) == 0 {
+		//select k distinct nodes to act as proxies
+		k := s.s.config.IndirectProbes
+		if k > len(upnodes) {
+			k = len(upnodes)
+		}
+		for i := 0; i < k; i++ {
+			j := i + s.rand.Intn(len(upnodes)-i)
+			upnodes[i], upnodes[j] = upnodes[j], upnodes[i]
+		}
+		if k == 0 {
 			glog.Errorf("ping failed with '%s' and no nodes to proxy ping request", err)
-			if target.setUp(false) {
-				s.s.genUpdates()
-			}
+			s.suspect(target)
 			return
 		}
-		//select 2 nodes to act as proxies
-		proxy1 := upnodes[rand.Intn(len(upnodes))]
-		proxy2 := upnodes[rand.Intn(len(upnodes))]
-		ack, err = s.pingreqack(proxy1, proxy2, &gen.PingReq{SourceNode: s.s.name, DestNode: target.name})
+		ack, err = s.pingreqack(upnodes[:k], &gen.PingReq{SourceNode: s.s.name, DestNode: target.name})
 		if err != nil {
 			glog.Errorf("Unable to proxy ping to %s: %s", target, err)
-			if target.setUp(false) {
-				s.s.genUpdates()
-			}
+			s.suspect(target)
 			return
 		}
 	}
-	if ack == nil {
-		//not
+	if ack == nil || !ack.Alive {
+		s.suspect(target)
+		return
 	}
-	if target.setUp(ack.Alive) {
-		s.s.genUpdates()
+	s.s.mu.Lock()
+	if old := target.update; target.seen(s.s.name) {
+		s.s.changed(target, old)
+	}
+	s.s.mu.Unlock()
+}
+
+//mark the target suspected, it will be confirmed dead unless it refutes the suspicion in time
+func (s *swimmer) suspect(target *node) {
+	s.s.mu.Lock()
+	defer s.s.mu.Unlock()
+	if old := target.update; target.suspect(s.s.name) {
+		glog.Infof("Swim: node %s is suspected", target.name)
+		s.s.changed(target, old)
 	}
-	//process ack
 }
 
 //set sequence in req packet, marshal and send it to specified node
 
 func (s *swimmer) sendRequest(addr *net.UDPAddr, req *gen.SwimMessage) error {
 	//add dissemination info to all outbound pkt
-	req.DisseminationUpdates = s.s.updates
+	s.s.requestLog(req, addr.String())
+	s.s.piggyback(req, addr.String())
 	bv, err := proto.Marshal(req)
 	if err != nil {
 		return err
 	}
+	if bv, err = s.s.seal(bv); err != nil {
+		return err
+	}
 	if len(bv) > len(s.buf) {
 		return errors.New("packet too big to send over UDP")
 	}
 	glog.V(2).Infof("sending %d bytes packet to node %s: %v", len(bv), addr, req)
-	sent, err := s.clientConn.WriteToUDP(bv, addr)
+	sent, err := s.clientConn.WriteTo(bv, addr)
 	if err != nil {
 		return err
 	}
@@ -151,19 +219,25 @@ func (s *swimmer) sendRequest(addr *net.UDPAddr, req *gen.SwimMessage) error {
 func (s *swimmer) receiveResponse(seq int64, to *gen.SwimMessage) (resp *gen.SwimMessage, err error) {
 	for {
 		glog.V(2).Infof("waiting for pkt")
-		n, _, err := s.clientConn.ReadFromUDP(s.buf[0:])
+		n, addr, err := s.clientConn.ReadFrom(s.buf[0:])
 		if err != nil {
 			return nil, err
 		}
 		glog.V(2).Infof("received pkt len %d", n)
 
-		err = proto.Unmarshal(s.buf[0:n], to)
+		pkt, err := s.s.open(s.buf[0:n], addr.String())
+		if err != nil {
+			continue //drop the packet, wait for the response until the deadline
+		}
+		err = proto.Unmarshal(pkt, to)
 		if err != nil {
 			return nil, err
 		}
 		glog.V(2).Info("received pkt ", to)
 		//process updates even if seq is out of order
 		s.s.onUpdatePkts(to.DisseminationUpdates)
+		s.s.onLogResponse(addr.String(), to)
+		s.s.onRateReports(to.RateReports)
 		if to.Seq == seq {
 			return to, nil
 		}
@@ -173,9 +247,9 @@ func (s *swimmer) receiveResponse(seq int64, to *gen.SwimMessage) (resp *gen.Swi
 
 //send ping and await ack
 func (s *swimmer) pingack(n *node, pkt *gen.Ping) (resp *gen.Ack, err error) {
-	s.clientConn.SetDeadline(time.Now().Add(rtt))
+	s.clientConn.SetDeadline(s.s.transport.Now().Add(s.s.config.ProbeTimeout))
 	s.seq = s.seq + 1
-	s.pb = gen.SwimMessage{Seq: s.seq, Ping: pkt, DisseminationUpdates: s.s.updates} //TODO: sync?
+	s.pb = gen.SwimMessage{Seq: s.seq, Ping: pkt}
 	err = s.sendRequest(n.addr, &s.pb)
 	if err != nil {
 		return
@@ -188,27 +262,29 @@ func (s *swimmer) pingack(n *node, pkt *gen.Ping) (resp *gen.Ack, err error) {
 	return r.Ack, nil
 }
 
-//send pingreq to 2 nodes and await first ack. second packet would be skipped
-//by receiver as oos on the next read
-func (s *swimmer) pingreqack(n1, n2 *node, pkt *gen.PingReq) (resp *gen.Ack, err error) {
-	s.clientConn.SetDeadline(time.Now().Add(rtt * 2))
+//send pingreq to proxies and await the first positive ack. Proxies unable to reach the target
+//respond with negative ack, late responses would be skipped by receiver as oos on the next read
+func (s *swimmer) pingreqack(proxies []*node, pkt *gen.PingReq) (resp *gen.Ack, err error) {
+	s.clientConn.SetDeadline(s.s.transport.Now().Add(s.s.config.ProbeTimeout * 2))
 	s.seq = s.seq + 1
-	s.pb = gen.SwimMessage{Seq: s.seq, PingReq: pkt}
-	var err1, err2 error
-	err1 = s.sendRequest(n1.addr, &s.pb)
-	if err1 != nil {
-		glog.Error("Unable to send packet to n1: ", err1)
-	}
-	if n2 != nil && n2 != n1 {
-		err2 = s.sendRequest(n2.addr, &s.pb)
-		if err2 != nil {
-			glog.Error("Unable to send packet to n2: ", err2)
+	sent := 0
+	for _, n := range proxies {
+		s.pb = gen.SwimMessage{Seq: s.seq, PingReq: pkt}
+		if err := s.sendRequest(n.addr, &s.pb); err != nil {
+			glog.Errorf("Unable to send packet to %s: %s", n.name, err)
+			continue
 		}
+		sent++
 	}
-	r, err := s.receiveResponse(s.seq, &s.pb)
-	if err != nil {
-		return
+	for ; sent > 0; sent-- {
+		r, err := s.receiveResponse(s.seq, &s.pb)
+		if err != nil {
+			return nil, err
+		}
+		s.s.onUpdatePkts(r.DisseminationUpdates)
+		if resp = r.Ack; resp != nil && resp.Alive {
+			break
+		}
 	}
-	s.s.onUpdatePkts(r.DisseminationUpdates)
-	return r.Ack, nil
+	return resp, nil
 }
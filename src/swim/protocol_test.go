@@ -1,6 +1,7 @@
 package swim
 
 import (
+	"fmt"
 	"net"
 	"testing"
 	"time"
@@ -10,12 +11,12 @@ import (
 )
 
 func TestSendReceive(t *testing.T) {
-	s1, err := NewSwim("127.0.0.1:1245")
+	s1, err := NewSwim("127.0.0.1:0")
 	if err != nil {
 		t.Fatal("Unable to create swim1: ", err)
 	}
 	defer s1.Close()
-	s2, err := NewSwim("127.0.0.1:1246")
+	s2, err := NewSwim("127.0.0.1:0")
 	if err != nil {
 		t.Fatal("Unable to create swim2: ", err)
 	}
@@ -30,7 +31,7 @@ func TestSendReceive(t *testing.T) {
 		t.Fatal("Unable to create swimmer2: ", err)
 	}
 	defer p2.close()
-	deadline := time.Now().Add(rtt)
+	deadline := time.Now().Add(s1.config.ProbeTimeout)
 	p1.clientConn.SetDeadline(deadline)
 	p2.clientConn.SetDeadline(deadline)
 	//addr1, err := parseIpPort("127.0.0.1:1245")
@@ -55,3 +56,51 @@ func TestSendReceive(t *testing.T) {
 		t.Error("Unexpected value of ping")
 	}
 }
+
+func TestIndirectProbes(t *testing.T) {
+	n := NewNetwork(1)
+	prober, err := NewSwimTransport("10.0.0.1:7946", n)
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer prober.Close()
+	c := prober.Config()
+	c.IndirectProbes = 3
+	if err := prober.SetConfig(c); err != nil {
+		t.Fatal(err)
+	}
+	var proxies []*Swim
+	var updates []*gen.DisseminationUpdateMsg
+	for i := 2; i < 7; i++ {
+		s, err := NewSwimTransport(fmt.Sprintf("10.0.0.%d:7946", i), n)
+		if err != nil {
+			t.Fatal("Unable to initialize Swim ", err)
+		}
+		defer s.Close()
+		n.Go(func() { s.Serve() })
+		proxies = append(proxies, s)
+		updates = append(updates, newUpdate(s.name, s.name, gen.NodeState_ALIVE, 0))
+	}
+	// nobody listens there
+	target := "10.0.0.100:7946"
+	updates = append(updates, newUpdate(target, target, gen.NodeState_ALIVE, 0))
+	prober.onUpdatePkts(updates)
+	n.Go(func() { prober.client.protoOnce(prober.nodesmap[target]) })
+	n.Run(time.Second)
+
+	if state := prober.nodesmap[target].State(); state != gen.NodeState_SUSPECT {
+		t.Error("Expected target to be suspected, got ", state)
+	}
+	asked := 0
+	for _, s := range proxies {
+		// proxies learn about the target from ping requests
+		s.mu.Lock()
+		if _, ok := s.nodesmap[target]; ok {
+			asked++
+		}
+		s.mu.Unlock()
+	}
+	if asked != 3 {
+		t.Error("Expected 3 distinct proxies, got ", asked)
+	}
+}
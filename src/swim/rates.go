@@ -0,0 +1,97 @@
+package swim
+
+import (
+	"sort"
+	"time"
+
+	"github.com/apesternikov/backplane/src/gen"
+	"github.com/golang/glog"
+)
+
+// reports not refreshed by their origin for this long are ignored
+var rateReportTTL = 10 * time.Second
+
+type rateReport struct {
+	report   *gen.RateReport
+	received time.Time
+}
+
+// ExchangeRates publishes local demand of cluster-wide rate limits and returns the latest demand
+// of other live nodes. Reports are attached to every packet along with dissemination updates.
+func (s *Swim) ExchangeRates(local map[string]float64) map[string]map[string]float64 {
+	report := &gen.RateReport{NodeName: s.name, Timestamp: now()}
+	for limiter, rate := range local {
+		report.Usage = append(report.Usage, &gen.RateUsage{Limiter: limiter, Rate: rate})
+	}
+	sort.Sort(byLimiter(report.Usage))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.transport.Now()
+	s.reports[s.name] = &rateReport{report: report, received: t}
+	peers := make(map[string]map[string]float64)
+	for name, r := range s.reports {
+		n, ok := s.nodesmap[name]
+		if name == s.name || !ok || !n.Up() || t.Sub(r.received) > rateReportTTL {
+			continue
+		}
+		rates := make(map[string]float64, len(r.report.Usage))
+		for _, u := range r.report.Usage {
+			rates[u.Limiter] = u.Rate
+		}
+		peers[name] = rates
+	}
+	return peers
+}
+
+// ownRateReport returns the report of this node if it is not expired
+// assumes s is locked
+func (s *Swim) ownRateReport() *gen.RateReport {
+	if r, ok := s.reports[s.name]; ok && s.transport.Now().Sub(r.received) <= rateReportTTL {
+		return r.report
+	}
+	return nil
+}
+
+// rateReports returns reports of other nodes fitting into budget bytes of the outbound packet.
+// Map order is random, so all reports get through in turn when they do not fit at once.
+// assumes s is locked
+func (s *Swim) rateReports(budget int) []*gen.RateReport {
+	var reports []*gen.RateReport
+	t := s.transport.Now()
+	for name, r := range s.reports {
+		if name == s.name || t.Sub(r.received) > rateReportTTL {
+			continue
+		}
+		if size := fieldSize(r.report); size <= budget {
+			reports = append(reports, r.report)
+			budget -= size
+		}
+	}
+	return reports
+}
+
+// apply reports newer than known ones
+func (s *Swim) onRateReports(reports []*gen.RateReport) {
+	if len(reports) == 0 {
+		return
+	}
+	t := s.transport.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, report := range reports {
+		if report.NodeName == s.name {
+			continue
+		}
+		if r, ok := s.reports[report.NodeName]; ok && r.report.Timestamp >= report.Timestamp {
+			continue
+		}
+		glog.V(3).Info("rate report ", report)
+		s.reports[report.NodeName] = &rateReport{report: report, received: t}
+	}
+}
+
+type byLimiter []*gen.RateUsage
+
+func (u byLimiter) Len() int           { return len(u) }
+func (u byLimiter) Less(i, j int) bool { return u[i].Limiter < u[j].Limiter }
+func (u byLimiter) Swap(i, j int)      { u[i], u[j] = u[j], u[i] }
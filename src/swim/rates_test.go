@@ -0,0 +1,62 @@
+package swim
+
+import (
+	"testing"
+
+	"github.com/apesternikov/backplane/src/gen"
+)
+
+func TestExchangeRates(t *testing.T) {
+	s1, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+	defer s1.Close()
+	s2, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+	defer s2.Close()
+
+	if peers := s2.ExchangeRates(map[string]float64{"backend b": 10}); len(peers) != 0 {
+		t.Error("Unexpected peers ", peers)
+	}
+	s1.ExchangeRates(map[string]float64{"backend b": 20})
+	s2.AddHosts(s1.name)
+	err = s2.client.sendRequest(s2.nodes[0].addr, &gen.SwimMessage{Seq: 1, Ping: &gen.Ping{SourceNode: s2.name}})
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+	s1.serveOnce()
+	peers := s1.ExchangeRates(map[string]float64{"backend b": 20})
+	if len(peers) != 1 || peers[s2.name]["backend b"] != 10 {
+		t.Error("Unexpected peers on s1 ", peers)
+	}
+	// the ack carries reports back
+	if _, err := s2.client.receiveResponse(1, &gen.SwimMessage{}); err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+	// s1 announced itself alive in the ack
+	if peers := s2.ExchangeRates(map[string]float64{"backend b": 10}); peers[s1.name]["backend b"] != 20 {
+		t.Error("Unexpected peers on s2 ", peers)
+	}
+	// reports of dead nodes are ignored
+	s2.mu.Lock()
+	s2.nodes[0].setState(gen.NodeState_DEAD, s2.name)
+	s2.mu.Unlock()
+	if peers := s2.ExchangeRates(map[string]float64{"backend b": 10}); len(peers) != 0 {
+		t.Error("Unexpected peers on s2 ", peers)
+	}
+	s2.mu.Lock()
+	s1report := s2.reports[s1.name]
+	s2.mu.Unlock()
+	if s1report == nil {
+		t.Fatal("expected s1 report on s2")
+	}
+	// older reports are ignored
+	s2.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate(s1.name, s1.name, gen.NodeState_ALIVE, 1)})
+	s2.onRateReports([]*gen.RateReport{{NodeName: s1.name, Timestamp: s1report.report.Timestamp - 1}})
+	if peers := s2.ExchangeRates(nil); len(peers[s1.name]) != 1 {
+		t.Error("Unexpected peers on s2 ", peers)
+	}
+}
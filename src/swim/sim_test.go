@@ -0,0 +1,203 @@
+package swim
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/apesternikov/backplane/src/gen"
+)
+
+// Simulations run clusters on the in-memory network in simulated time
+
+var simConfig = DefaultConfig()
+
+// cluster of size nodes joining through the first one
+func simCluster(t *testing.T, n *Network, size int) []*Swim {
+	var swims []*Swim
+	for i := 0; i < size; i++ {
+		s, err := NewSwimTransport(fmt.Sprintf("10.0.%d.%d:7946", i/250, i%250+1), n)
+		if err != nil {
+			t.Fatal("Unable to initialize Swim ", err)
+		}
+		if i > 0 {
+			s.AddHosts(swims[0].name)
+		}
+		swims = append(swims, s)
+		n.Go(func() { s.Serve() })
+	}
+	return swims
+}
+
+func closeAll(swims []*Swim) {
+	for _, s := range swims {
+		s.Close()
+	}
+}
+
+// run the simulation in steps of the protocol period until cond holds, returns the simulated time it took
+func runUntil(t *testing.T, n *Network, limit time.Duration, what string, cond func() bool) time.Duration {
+	start := n.Now()
+	for !cond() {
+		if d := n.Now().Sub(start); d >= limit {
+			t.Fatalf("%s did not happen in %s", what, d)
+		}
+		n.Run(simConfig.ProbeInterval / 10)
+	}
+	return n.Now().Sub(start)
+}
+
+// number of (observer, node) pairs where the observer sees the node in the state
+func seen(observers, nodes []*Swim, state gen.NodeState) (count int) {
+	for _, o := range observers {
+		for _, s := range nodes {
+			if s == o {
+				continue
+			}
+			if m, ok := o.Member(s.name); ok && m.State == state || !ok && state == gen.NodeState_DEAD {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// all nodes see each other in the state
+func converged(observers, nodes []*Swim, state gen.NodeState) bool {
+	pairs := len(observers) * len(nodes)
+	for _, o := range observers {
+		for _, s := range nodes {
+			if s == o {
+				pairs--
+			}
+		}
+	}
+	return seen(observers, nodes, state) == pairs
+}
+
+// refutations made by the nodes, each of them follows a false suspicion
+func refutations(swims []*Swim) (count int64) {
+	for _, s := range swims {
+		count += s.Members()[0].Incarnation
+	}
+	return count
+}
+
+func without(swims []*Swim, s *Swim) []*Swim {
+	var live []*Swim
+	for _, o := range swims {
+		if o != s {
+			live = append(live, o)
+		}
+	}
+	return live
+}
+
+type detection struct {
+	joined, suspected, confirmed time.Duration
+	refutations                  int64
+}
+
+// run a cluster of size nodes, kill one and measure how long it takes to detect the failure
+func simulateFailure(t *testing.T, seed int64, size int, loss float64) detection {
+	var d detection
+	n := NewNetwork(seed)
+	n.SetLatency(time.Millisecond, 4*time.Millisecond)
+	swims := simCluster(t, n, size)
+	defer closeAll(swims)
+	d.joined = runUntil(t, n, 2*time.Minute, "join", func() bool { return converged(swims, swims, gen.NodeState_ALIVE) })
+
+	n.SetLoss(loss)
+	failed := swims[size/2]
+	live := without(swims, failed)
+	failed.Close()
+	d.suspected = runUntil(t, n, time.Minute, "suspicion", func() bool {
+		return seen(live, []*Swim{failed}, gen.NodeState_ALIVE) < len(live)
+	})
+	d.confirmed = d.suspected + runUntil(t, n, time.Minute, "confirmation", func() bool {
+		return converged(live, []*Swim{failed}, gen.NodeState_DEAD)
+	})
+	if dead := seen(live, live, gen.NodeState_DEAD); dead != 0 {
+		t.Errorf("%d live nodes confirmed dead", dead)
+	}
+	d.refutations = refutations(live)
+	return d
+}
+
+func TestSimulatedFailureDetection(t *testing.T) {
+	if testing.Short() {
+		t.Skip("100 nodes simulation")
+	}
+	d := simulateFailure(t, 1, 100, 0)
+	t.Logf("100 nodes joined in %s, failure suspected in %s, confirmed in %s", d.joined, d.suspected, d.confirmed)
+	if d.suspected > 3*simConfig.ProbeInterval {
+		t.Error("Failure detected too late ", d.suspected)
+	}
+	if d.confirmed > d.suspected+simConfig.suspicionTimeout()+5*simConfig.ProbeInterval {
+		t.Error("Failure confirmed too late ", d.confirmed)
+	}
+	if d.refutations != 0 {
+		t.Error("Unexpected false suspicions without packet loss ", d.refutations)
+	}
+}
+
+func TestSimulatedFalsePositives(t *testing.T) {
+	if testing.Short() {
+		t.Skip("100 nodes simulation")
+	}
+	n := NewNetwork(1)
+	n.SetLatency(time.Millisecond, 4*time.Millisecond)
+	swims := simCluster(t, n, 100)
+	defer closeAll(swims)
+	runUntil(t, n, 2*time.Minute, "join", func() bool { return converged(swims, swims, gen.NodeState_ALIVE) })
+	before := refutations(swims)
+
+	n.SetLoss(0.05)
+	period := time.Minute
+	n.Run(period)
+	suspicions := refutations(swims) - before
+	// every node probes a node every protocol period
+	probes := float64(len(swims)) * float64(period) / float64(simConfig.ProbeInterval)
+	t.Logf("5%% packet loss: %d false suspicions in %.0f probes", suspicions, probes)
+	if rate := float64(suspicions) / probes; rate > 0.01 {
+		t.Error("False positive rate is too high ", rate)
+	}
+	if dead := seen(swims, swims, gen.NodeState_DEAD); dead != 0 {
+		t.Errorf("%d live nodes confirmed dead", dead)
+	}
+}
+
+func TestSimulatedPartition(t *testing.T) {
+	if testing.Short() {
+		t.Skip("100 nodes simulation")
+	}
+	n := NewNetwork(1)
+	n.SetLatency(time.Millisecond, 4*time.Millisecond)
+	swims := simCluster(t, n, 100)
+	defer closeAll(swims)
+	runUntil(t, n, 2*time.Minute, "join", func() bool { return converged(swims, swims, gen.NodeState_ALIVE) })
+
+	left, right := swims[:50], swims[50:]
+	var hosts []string
+	for _, s := range right {
+		hosts = append(hosts, s.name)
+	}
+	n.Partition(hosts...)
+	split := runUntil(t, n, 2*time.Minute, "split", func() bool {
+		return converged(left, right, gen.NodeState_DEAD) && converged(right, left, gen.NodeState_DEAD)
+	})
+	if dead := seen(left, left, gen.NodeState_DEAD) + seen(right, right, gen.NodeState_DEAD); dead != 0 {
+		t.Errorf("%d nodes confirmed dead within their partition", dead)
+	}
+	n.Heal()
+	healed := runUntil(t, n, 2*time.Minute, "heal", func() bool { return converged(swims, swims, gen.NodeState_ALIVE) })
+	t.Logf("partition detected in %s, healed in %s", split, healed)
+}
+
+func TestSimulationIsDeterministic(t *testing.T) {
+	d1 := simulateFailure(t, 2, 20, 0.05)
+	d2 := simulateFailure(t, 2, 20, 0.05)
+	if d1 != d2 {
+		t.Error("Expected the same simulation to run the same way ", d1, d2)
+	}
+}
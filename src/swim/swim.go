@@ -7,12 +7,20 @@
 // with several differences:
 // - down nodes are not removed from the list but marked as down to handle netsplits
 //
+// Failure detection:
+// A node failing to respond to ping and ping_req is marked SUSPECT and confirmed DEAD
+// if the suspicion is not refuted within the suspicion timeout (see Config). Every node has an incarnation number
+// only the node itself can bump (see supersedes for precedence). Hearing a suspicion or death about
+// itself the node refutes it with ALIVE update of a higher incarnation, this is also how
+// a node marked DEAD rejoins after a netsplit or a restart.
+//
 // Dissemination:
 // All incoming updates are applied to local in-memory state.
 // If the incoming update is new to the system it is updated to the outbound log.
 // Each item in the outbound log has local sequence number. Each node stores last seen sequence numbers from all remote nodes
 // as well as last local seq confirmed by remote node.
 // Each ping request contains remote log fetch request after particluar (last seen) seq
+// Nodes too far behind the remote log exchange full state over TCP (push-pull).
 
 package swim
 
@@ -30,9 +38,6 @@ import (
 	"github.com/golang/protobuf/proto"
 )
 
-var protoPreiod = 1000 * time.Millisecond
-var rtt = 200 * time.Millisecond
-
 var now func() int64 = func() int64 {
 	return time.Now().UnixNano()
 }
@@ -42,6 +47,8 @@ type node struct {
 	name        string
 	lastChanged time.Time
 	update      *gen.DisseminationUpdateMsg
+	remoteSeq   int64 //last seq of the node log seen
+	ackedSeq    int64 //last seq of the local log the node confirmed
 }
 
 func NewNode(hostport string) (n *node, err error) {
@@ -52,44 +59,105 @@ func NewNode(hostport string) (n *node, err error) {
 	return &node{addr: a, name: hostport}, nil
 }
 
+// supersedes tells if update a takes precedence over b about the same node:
+// higher incarnation wins, on the same incarnation DEAD overrides SUSPECT which overrides ALIVE.
+// Only the node itself bumps its incarnation, so it can refute suspicion and come back from DEAD.
+func supersedes(a, b *gen.DisseminationUpdateMsg) bool {
+	if a.Incarnation != b.Incarnation {
+		return a.Incarnation > b.Incarnation
+	}
+	return a.State > b.State
+}
+
+func newUpdate(name, origin string, state gen.NodeState, incarnation int64) *gen.DisseminationUpdateMsg {
+	return &gen.DisseminationUpdateMsg{
+		Timestamp:   now(),
+		NodeName:    name,
+		Alive:       state != gen.NodeState_DEAD,
+		Origin:      origin,
+		State:       state,
+		Incarnation: incarnation,
+	}
+}
+
 func (n *node) setFromUpdate(update *gen.DisseminationUpdateMsg) (updated bool) {
-	if n.update == nil || update.Alive != n.update.Alive {
+	if n.update == nil || supersedes(update, n.update) {
 		n.update = update
-		n.lastChanged = time.Now()
 		glog.V(1).Info("node remote state ", n)
 		return true
 	}
 	return false
 }
 
-//mark node as up and (re)generate update message if needed
-func (n *node) setUp(isUp bool) (updated bool) {
-	if n.update == nil || isUp != n.update.Alive {
-		update := &gen.DisseminationUpdateMsg{
-			Timestamp: now(),
-			NodeName:  n.name,
-			Alive:     isUp,
+// change the state of the node keeping its incarnation and generate update message if needed
+func (n *node) setState(state gen.NodeState, origin string) (updated bool) {
+	var incarnation int64
+	if n.update != nil {
+		if n.update.State == state {
+			return false
 		}
-		n.update = update
-		n.lastChanged = time.Now()
-		glog.V(1).Info("node local state ", n)
-		return true
+		incarnation = n.update.Incarnation
+	}
+	update := newUpdate(n.name, origin, state, incarnation)
+	if n.update != nil {
+		if !supersedes(update, n.update) {
+			return false
+		}
+		update.Meta = n.update.Meta
+	}
+	n.update = update
+	glog.V(1).Info("node local state ", n)
+	return true
+}
+
+// the node responded directly. It is marked alive only if nothing is known about it yet,
+// otherwise the node has to refute suspicion or death itself by bumping the incarnation
+func (n *node) seen(origin string) (updated bool) {
+	if n.update == nil {
+		return n.setState(gen.NodeState_ALIVE, origin)
 	}
 	return false
 }
 
-func (n *node) Up() bool {
+// the node failed to respond to ping and ping_req
+func (n *node) suspect(origin string) (updated bool) {
+	if n.update == nil || n.update.State != gen.NodeState_ALIVE {
+		return false
+	}
+	return n.setState(gen.NodeState_SUSPECT, origin)
+}
+
+// confirm the node dead if it is suspected for longer than suspicion timeout
+func (n *node) expireSuspicion(origin string, t time.Time, timeout time.Duration) (updated bool) {
+	if n.State() != gen.NodeState_SUSPECT || t.Sub(n.lastChanged) < timeout {
+		return false
+	}
+	return n.setState(gen.NodeState_DEAD, origin)
+}
+
+// State returns the last known state. Nodes never heard of are DEAD
+func (n *node) State() gen.NodeState {
 	if n.update != nil {
-		return n.update.Alive
+		return n.update.State
 	}
-	return false
+	return gen.NodeState_DEAD
+}
+
+// Up tells if the node is a member of the cluster. Suspected nodes are still members until confirmed dead
+func (n *node) Up() bool {
+	return n.update != nil && n.update.State != gen.NodeState_DEAD
 }
 
 func (n *node) String() string {
 	var state string
-	if n.Up() {
+	switch {
+	case n.update == nil:
+		state = "DOWN"
+	case n.update.State == gen.NodeState_ALIVE:
 		state = "UP"
-	} else {
+	case n.update.State == gen.NodeState_SUSPECT:
+		state = "SUSPECT"
+	default:
 		state = "DOWN"
 	}
 	return fmt.Sprintf("Node %s %s changed %s %s", n.addr, state, n.lastChanged.Format(time.RFC1123), n.update)
@@ -97,22 +165,50 @@ func (n *node) String() string {
 
 type Swim struct {
 	name       string       //swim node id
+	clock      LamportClock //incarnation of this node
 	Addr       *net.UDPAddr //local udp address
-	serverConn *net.UDPConn
-	client     *swimmer //this client is used by server side to execute ping_req
+	serverConn net.PacketConn
+	client     *swimmer  //buffer of this client receives server side packets
+	transport  Transport //network, clock and goroutines of the protocol
+	config     Config
+
+	tcpListener net.Listener //push-pull on the same port
+	pushPullReq chan *node
+	closed      chan struct{}
+	closeOnce   sync.Once
+
+	keyring      *Keyring //encrypts packets when set
+	authFailures int64    //packets dropped failing authentication
 
 	mu       sync.Mutex
+	probers  []*swimmer //clients of finished probes, reused by next probes
 	nodes    []*node //all nodes in the network excluding itself. TODO: split into dclocal and dcremote
 	nodesmap map[string]*node
-	updates  []*gen.DisseminationUpdateMsg //current implementation send all updates.
+	self     *gen.DisseminationUpdateMsg   //state of this node as announced to others
+	queue    []*broadcast                  //updates to piggyback on outbound packets
+	queued   int64                         //number of updates ever queued
+	reports  map[string]*rateReport        //rate reports by node name including itself
+
+	log        []*logEntry //outbound log, latest update of every node ordered by seq
+	logSeq     int64       //seq of the last log entry
+	logTrimmed int64       //seq of the last entry trimmed from the log
+
+	left        bool //this node announced it is leaving
+	subscribers []*subscriber
 }
 
 func (s *Swim) HandleStatus(rw http.ResponseWriter, req *http.Request) {
 	fmt.Fprintf(rw, "name: %s\n", s.name)
+	fmt.Fprintf(rw, "incarnation: %d\n", s.clock.GetEpoch())
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	fmt.Fprintf(rw, "meta: %s\n", formatMeta(s.self.Meta))
+	if s.keyring != nil {
+		fmt.Fprintf(rw, "encrypted, auth failures: %d\n", s.AuthFailures())
+	}
+	fmt.Fprintf(rw, "log seq: %d entries: %d\n", s.logSeq, len(s.log))
 	for _, n := range s.nodes {
-		fmt.Fprintf(rw, "%s\n", n)
+		fmt.Fprintf(rw, "%s seen seq %d acked seq %d\n", n, n.remoteSeq, n.ackedSeq)
 	}
 }
 
@@ -135,18 +231,45 @@ func parseIpPort(hostport string) (addr *net.UDPAddr, err error) {
 }
 
 func NewSwim(localhostport string) (s *Swim, err error) {
+	return NewSwimTransport(localhostport, UDPTransport{})
+}
+
+// NewSwimTransport creates the node communicating over the transport
+func NewSwimTransport(localhostport string, t Transport) (s *Swim, err error) {
 	s = new(Swim)
 	s.Addr, err = parseIpPort(localhostport)
 	if err != nil {
 		return
 	}
+	s.transport = t
+	s.config = DefaultConfig()
 	s.nodesmap = make(map[string]*node)
-	s.serverConn, err = net.ListenUDP(s.Addr.Network(), s.Addr)
-	if err != nil {
-		glog.Errorf("Swim: Unable to listen on local udp %s: %s", s.Addr, err)
-		return nil, err
+	s.reports = make(map[string]*rateReport)
+	s.pushPullReq = make(chan *node, 1)
+	s.closed = make(chan struct{})
+	s.logSeq = logStart()
+	var local *net.UDPAddr
+	for attempt := 0; ; attempt++ {
+		s.serverConn, err = s.transport.ListenPacket(s.Addr)
+		if err != nil {
+			glog.Errorf("Swim: Unable to listen on local udp %s: %s", s.Addr, err)
+			return nil, err
+		}
+		local = s.serverConn.LocalAddr().(*net.UDPAddr)
+		s.tcpListener, err = s.transport.ListenStream(local)
+		if err == nil {
+			break
+		}
+		s.serverConn.Close()
+		// ephemeral udp port may be taken over tcp, try another one
+		if s.Addr.Port != 0 || attempt == 10 {
+			glog.Errorf("Swim: Unable to listen on local tcp %s: %s", local, err)
+			return nil, err
+		}
 	}
-	s.name = s.serverConn.LocalAddr().String()
+	s.name = local.String()
+	s.self = newUpdate(s.name, s.name, gen.NodeState_ALIVE, s.clock.GetEpoch())
+	s.broadcast(s.self)
 	glog.Info("Swim: serving ", s.name)
 	s.client, err = newSwimmer(s)
 	return
@@ -187,7 +310,9 @@ func (s *Swim) getHost(host string) (n *node, err error) {
 }
 
 func (s *Swim) Serve() (err error) {
-	go s.client.protoLoop()
+	s.transport.Go(s.protoLoop)
+	s.transport.Go(s.servePushPulls)
+	s.transport.Go(s.pushPullLoop)
 	for {
 		if err = s.serveOnce(); err != nil {
 			return
@@ -198,14 +323,18 @@ func (s *Swim) Serve() (err error) {
 func (s *Swim) serveOnce() error {
 	var in, out gen.SwimMessage
 	// out.Reset()
-	n, addr, err := s.serverConn.ReadFromUDP(s.client.buf[0:])
+	n, addr, err := s.serverConn.ReadFrom(s.client.buf[0:])
 	if err != nil {
 		glog.Error("error serving swim: ", err)
 		return err
 	}
 	glog.V(2).Infof("received pkt len %d form %s", n, addr)
 
-	err = proto.Unmarshal(s.client.buf[0:n], &in)
+	pkt, err := s.open(s.client.buf[0:n], addr.String())
+	if err != nil {
+		return nil //drop the packet, continue loop
+	}
+	err = proto.Unmarshal(pkt, &in)
 	if err != nil {
 		glog.Error("error unmarshalling swim: ", err)
 		return nil //ignore error, continue loop
@@ -213,16 +342,35 @@ func (s *Swim) serveOnce() error {
 	glog.V(2).Info("swim serving inpkt ", &in)
 	//first, apply all db updates
 	s.onUpdatePkts(in.DisseminationUpdates)
+	s.onRateReports(in.RateReports)
 	out.Seq = in.Seq
+	var src string
 	switch {
 	case in.Ping != nil:
+		src = in.Ping.SourceNode
 		out.Ack = s.servePing(in.Ping)
 	case in.PingReq != nil:
-		out.Ack = s.servePingReq(in.PingReq)
+		// the indirect ping waits for the target, packets of other nodes are served meanwhile
+		s.transport.Go(func() {
+			out.Ack = s.servePingReq(in.PingReq)
+			if err := s.respond(&out, in.PingReq.SourceNode, in.KnownDestSeq, addr); err != nil {
+				glog.Error("error responding to ping req: ", err)
+			}
+		})
+		return nil
 	}
+	return s.respond(&out, src, in.KnownDestSeq, addr)
+}
+
+// respond sends the response to the original peer address of the request
+func (s *Swim) respond(out *gen.SwimMessage, src string, known int64, addr net.Addr) error {
 	//add db
-	out.DisseminationUpdates = s.updates
-	bv, err := proto.Marshal(&out)
+	s.serveLog(out, src, known)
+	s.piggyback(out, src)
+	bv, err := proto.Marshal(out)
+	if err == nil {
+		bv, err = s.seal(bv)
+	}
 	if err != nil {
 		glog.Error("error marshalling swim response: ", err)
 		return nil //ignore error, continue loop
@@ -231,8 +379,8 @@ func (s *Swim) serveOnce() error {
 		glog.Error("packet is too big to send over UDP")
 		return nil //ignore error, continue loop
 	}
-	glog.V(2).Infof("sending %d bytes packet to node %s: %v", len(bv), addr, &out)
-	sent, err := s.serverConn.WriteToUDP(bv, addr) //responding to the original peer address
+	glog.V(2).Infof("sending %d bytes packet to node %s: %v", len(bv), addr, out)
+	sent, err := s.serverConn.WriteTo(bv, addr) //responding to the original peer address
 	if err != nil {
 		glog.Error("error sending swim response: ", err)
 		return nil //ignore error, continue loop
@@ -250,9 +398,11 @@ func (s *Swim) servePing(pkt *gen.Ping) *gen.Ack {
 		glog.Error("getHost: ", err)
 		return nil
 	}
-	if n.setUp(true) {
-		s.genUpdates()
+	s.mu.Lock()
+	if old := n.update; n.seen(s.name) {
+		s.changed(n, old)
 	}
+	s.mu.Unlock()
 	return &gen.Ack{Alive: true}
 }
 
@@ -263,16 +413,24 @@ func (s *Swim) servePingReq(pkt *gen.PingReq) *gen.Ack {
 		glog.Error("getHost: ", err)
 		return nil
 	}
-	if src.setUp(true) {
-		s.genUpdates()
+	s.mu.Lock()
+	if old := src.update; src.seen(s.name) {
+		s.changed(src, old)
 	}
+	s.mu.Unlock()
 	//get the dest node
 	dst, err := s.getHost(pkt.DestNode)
 	if err != nil {
 		glog.Error("getHost: ", err)
 		return nil
 	}
-	ack, err := s.client.pingack(dst, &gen.Ping{s.name})
+	c, err := s.getProber()
+	if err != nil {
+		glog.Error("getProber: ", err)
+		return nil
+	}
+	defer s.putProber(c)
+	ack, err := c.pingack(dst, &gen.Ping{s.name})
 	if err != nil {
 		glog.Error("pingack: ", err)
 		return &gen.Ack{Alive: false}
@@ -280,14 +438,56 @@ func (s *Swim) servePingReq(pkt *gen.PingReq) *gen.Ack {
 	return ack
 }
 
+// Leave announces this node is leaving the cluster, so others mark it DEAD without waiting
+// for the suspicion timeout. The announcement is sent to all live nodes before Leave returns,
+// the node does not refute it until restarted.
+func (s *Swim) Leave() error {
+	s.mu.Lock()
+	s.left = true
+	meta := s.self.Meta
+	s.self = newUpdate(s.name, s.name, gen.NodeState_DEAD, s.self.Incarnation)
+	s.self.Meta = meta
+	s.broadcast(s.self)
+	var nodes []*node
+	for _, n := range s.nodes {
+		if n.Up() {
+			nodes = append(nodes, n)
+		}
+	}
+	s.mu.Unlock()
+	glog.Info("Swim: leaving ", s.name)
+	// protocol loop uses s.client
+	client, err := newSwimmer(s)
+	if err != nil {
+		return err
+	}
+	defer client.close()
+	for _, n := range nodes {
+		if _, err := client.pingack(n, &gen.Ping{SourceNode: s.name}); err != nil {
+			glog.Errorf("Swim: unable to announce leave to %s: %s", n.name, err)
+		}
+	}
+	return nil
+}
+
 //close connections
 func (s *Swim) Close() {
 	if s.serverConn != nil {
 		s.serverConn.Close()
 	}
+	if s.tcpListener != nil {
+		s.tcpListener.Close()
+	}
+	s.closeOnce.Do(func() { close(s.closed) })
 	if s.client != nil {
 		s.client.close()
 	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.probers {
+		c.close()
+	}
+	s.probers = nil
 }
 
 //db functions
@@ -296,6 +496,13 @@ func (s *Swim) Close() {
 //returns true if data is updated
 func (s *Swim) onUpdatePkt(pkt *gen.DisseminationUpdateMsg) bool {
 	var err error
+	if size := metaSize(pkt.Meta); size > maxMetaSize {
+		glog.Errorf("Swim: ignoring update of %s with metadata size %d", pkt.NodeName, size)
+		return false
+	}
+	if pkt.NodeName == s.name {
+		return s.refute(pkt)
+	}
 	n, ok := s.nodesmap[pkt.NodeName]
 	if !ok {
 		n, err = NewNode(pkt.NodeName)
@@ -306,34 +513,53 @@ func (s *Swim) onUpdatePkt(pkt *gen.DisseminationUpdateMsg) bool {
 		s.nodes = append(s.nodes, n)
 		s.nodesmap[pkt.NodeName] = n
 	}
-	if n.setFromUpdate(pkt) {
-		// the info in update is new, update the record
-		s.genUpdates()
+	if old := n.update; n.setFromUpdate(pkt) {
+		// the info in update is new, pass it on
+		s.changed(n, old)
 		return true
 	}
 	return false
 }
 
-//regenerate outbound updates
-//assumes mutex is locked
-func (s *Swim) genUpdates() {
-	s.updates = s.updates[0:0]
+//refute suspicion or death of this node announcing a higher incarnation.
+//Stale announcements of the node, like metadata before restart, are refuted as well.
+//assumes s is locked
+func (s *Swim) refute(pkt *gen.DisseminationUpdateMsg) bool {
+	if s.left || pkt.Incarnation < s.self.Incarnation {
+		return false
+	}
+	if pkt.Incarnation == s.self.Incarnation && pkt.State == gen.NodeState_ALIVE && metaEqual(pkt.Meta, s.self.Meta) {
+		return false
+	}
+	incarnation := s.clock.OnReceivedEpoch(pkt.Incarnation)
+	glog.Infof("Swim: refuting %s by %s with incarnation %d", pkt.State, pkt.Origin, incarnation)
+	meta := s.self.Meta
+	s.self = newUpdate(s.name, s.name, gen.NodeState_ALIVE, incarnation)
+	s.self.Meta = meta
+	s.broadcast(s.self)
+	return true
+}
+
+//confirm dead nodes which did not refute suspicion in time
+func (s *Swim) expireSuspicions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, timeout := s.transport.Now(), s.config.suspicionTimeout()
 	for _, n := range s.nodes {
-		if n.update != nil {
-			s.updates = append(s.updates, n.update)
+		if old := n.update; n.expireSuspicion(s.name, t, timeout) {
+			glog.Infof("Swim: node %s confirmed dead", n.name)
+			s.changed(n, old)
 		}
 	}
-
 }
 
 func (s *Swim) onUpdatePkts(pkts []*gen.DisseminationUpdateMsg) (updated bool) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	for _, pkt := range pkts {
-		updated = updated || s.onUpdatePkt(pkt)
-	}
-	if updated {
-		s.genUpdates()
+		if s.onUpdatePkt(pkt) {
+			updated = true
+		}
 	}
 	return
 }
@@ -2,6 +2,7 @@ package swim
 
 import (
 	"net"
+	"time"
 
 	"github.com/apesternikov/backplane/src/gen"
 
@@ -35,7 +36,7 @@ func TestParseBadIpPort(t *testing.T) {
 }
 
 func TestAddHosts(t *testing.T) {
-	s, err := NewSwim("127.0.0.1:1234")
+	s, err := NewSwim("127.0.0.1:0")
 	if err != nil {
 		t.Error("Unable to initialize Swim ", err)
 	}
@@ -66,13 +67,13 @@ func TestAddHosts(t *testing.T) {
 
 func TestPing(t *testing.T) {
 	var err error
-	s1, err := NewSwim("127.0.0.1:1234")
+	s1, err := NewSwim("127.0.0.1:0")
 	if err != nil {
 		t.Error("Unexpected error ", err)
 	}
 	defer s1.Close()
 
-	s2, err := NewSwim("127.0.0.1:1235")
+	s2, err := NewSwim("127.0.0.1:0")
 	if err != nil {
 		t.Error("Unexpected error ", err)
 	}
@@ -101,21 +102,21 @@ func TestPing(t *testing.T) {
 func TestPingReqToUpNode(t *testing.T) {
 	var err error
 	//proxy
-	s1, err := NewSwim("127.0.0.1:1234")
+	s1, err := NewSwim("127.0.0.1:0")
 	if err != nil {
 		t.Error("Unexpected error ", err)
 	}
 	defer s1.Close()
 
 	//source
-	s2, err := NewSwim("127.0.0.1:1235")
+	s2, err := NewSwim("127.0.0.1:0")
 	if err != nil {
 		t.Error("Unexpected error ", err)
 	}
 	defer s2.Close()
 
 	//target
-	s3, err := NewSwim("127.0.0.1:1236")
+	s3, err := NewSwim("127.0.0.1:0")
 	if err != nil {
 		t.Error("Unexpected error ", err)
 	}
@@ -146,14 +147,14 @@ func TestPingReqToUpNode(t *testing.T) {
 func TestPingReqToDownNode(t *testing.T) {
 	var err error
 	//proxy
-	s1, err := NewSwim("127.0.0.1:1234")
+	s1, err := NewSwim("127.0.0.1:0")
 	if err != nil {
 		t.Error("Unexpected error ", err)
 	}
 	defer s1.Close()
 
 	//source
-	s2, err := NewSwim("127.0.0.1:1235")
+	s2, err := NewSwim("127.0.0.1:0")
 	if err != nil {
 		t.Error("Unexpected error ", err)
 	}
@@ -187,3 +188,157 @@ func TestPingReqToDownNode(t *testing.T) {
 		t.Error("Unexpected response ", msg)
 	}
 }
+
+func TestPingReqDoesNotBlockServing(t *testing.T) {
+	//proxy
+	s1, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+	defer s1.Close()
+	//source
+	s2, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+	defer s2.Close()
+	s2.AddHosts(s1.name)
+
+	// the target is down, the indirect ping waits for the whole probe timeout
+	err = s2.client.sendRequest(s2.nodes[0].addr, &gen.SwimMessage{Seq: 2, PingReq: &gen.PingReq{SourceNode: s2.name, DestNode: "127.0.0.1:1236"}})
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+	err = s2.client.sendRequest(s2.nodes[0].addr, &gen.SwimMessage{Seq: 3, Ping: &gen.Ping{SourceNode: s2.name}})
+	if err != nil {
+		t.Fatal("Unexpected error ", err)
+	}
+	go func() {
+		s1.serveOnce()
+		s1.serveOnce()
+	}()
+
+	s2.client.clientConn.SetDeadline(time.Now().Add(s1.config.ProbeTimeout / 2))
+	msg, err := s2.client.receiveResponse(3, &gen.SwimMessage{})
+	if err != nil || msg.Ack == nil || !msg.Ack.Alive {
+		t.Fatal("Expected ping to be answered during the indirect ping, got ", msg, err)
+	}
+	s2.client.clientConn.SetDeadline(time.Now().Add(s1.config.ProbeTimeout * 2))
+	msg, err = s2.client.receiveResponse(2, &gen.SwimMessage{})
+	if err != nil || msg.Ack == nil || msg.Ack.Alive {
+		t.Error("Unexpected response ", msg, err)
+	}
+}
+
+func TestSupersedes(t *testing.T) {
+	alive0 := newUpdate("n", "o", gen.NodeState_ALIVE, 0)
+	suspect0 := newUpdate("n", "o", gen.NodeState_SUSPECT, 0)
+	dead0 := newUpdate("n", "o", gen.NodeState_DEAD, 0)
+	alive1 := newUpdate("n", "n", gen.NodeState_ALIVE, 1)
+	for _, tc := range []struct {
+		a, b     *gen.DisseminationUpdateMsg
+		expected bool
+	}{
+		{suspect0, alive0, true},
+		{dead0, suspect0, true},
+		{dead0, alive0, true},
+		{alive0, suspect0, false},
+		{alive0, alive0, false},
+		{suspect0, dead0, false},
+		{alive1, suspect0, true},
+		{alive1, dead0, true},
+		{dead0, alive1, false},
+	} {
+		if got := supersedes(tc.a, tc.b); got != tc.expected {
+			t.Errorf("%s over %s: expected %v", tc.a, tc.b, tc.expected)
+		}
+	}
+}
+
+func TestSuspicion(t *testing.T) {
+	s, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s.Close()
+	c := s.Config()
+	c.ProbeInterval = 10 * time.Millisecond
+	if err := s.SetConfig(c); err != nil {
+		t.Fatal(err)
+	}
+	suspicionTimeout := c.suspicionTimeout()
+	// nobody listens there
+	s.AddHosts("127.0.0.1:1")
+	n := s.nodes[0]
+	s.client.protoOnce(n)
+	if n.Up() || n.update != nil {
+		t.Fatal("Node never seen should not be suspected ", n)
+	}
+	s.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate(n.name, n.name, gen.NodeState_ALIVE, 3)})
+	s.client.protoOnce(n)
+	if n.State() != gen.NodeState_SUSPECT || !n.Up() || n.update.Incarnation != 3 || n.update.Origin != s.name {
+		t.Fatal("Expected node to be suspected ", n)
+	}
+	s.expireSuspicions()
+	if n.State() != gen.NodeState_SUSPECT {
+		t.Fatal("Expected node to be suspected until timeout ", n)
+	}
+	time.Sleep(suspicionTimeout)
+	s.expireSuspicions()
+	if n.State() != gen.NodeState_DEAD || n.Up() || n.update.Incarnation != 3 {
+		t.Fatal("Expected node to be confirmed dead ", n)
+	}
+	// rumors of the same incarnation do not resurrect the node
+	s.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate(n.name, "other", gen.NodeState_ALIVE, 3)})
+	if n.Up() {
+		t.Fatal("Expected node to stay dead ", n)
+	}
+	// the node itself does
+	s.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate(n.name, n.name, gen.NodeState_ALIVE, 4)})
+	if n.State() != gen.NodeState_ALIVE {
+		t.Fatal("Expected node to rejoin ", n)
+	}
+}
+
+func TestRefute(t *testing.T) {
+	s1, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s1.Close()
+	s2, err := NewSwim("127.0.0.1:0")
+	if err != nil {
+		t.Fatal("Unable to initialize Swim ", err)
+	}
+	defer s2.Close()
+	s2.AddHosts(s1.name)
+	s2.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate(s1.name, s1.name, gen.NodeState_ALIVE, 0)})
+	s2.nodes[0].suspect(s2.name)
+	s2.broadcast(s2.nodes[0].update)
+
+	// s1 learns about the suspicion from the ping and refutes it in the ack
+	served := make(chan struct{})
+	go func() {
+		s1.serveOnce()
+		close(served)
+	}()
+	ack, err := s2.client.pingack(s2.nodes[0], &gen.Ping{SourceNode: s2.name})
+	if err != nil || !ack.Alive {
+		t.Fatal("Unexpected ping result ", ack, err)
+	}
+	<-served
+	if s1.clock.GetEpoch() != 1 || s1.self.Incarnation != 1 {
+		t.Error("Expected s1 to bump incarnation ", s1.self)
+	}
+	if n := s2.nodes[0]; n.State() != gen.NodeState_ALIVE || n.update.Incarnation != 1 {
+		t.Error("Expected suspicion to be refuted ", n)
+	}
+	if len(s1.nodes) != 1 || s1.nodes[0].name != s2.name {
+		t.Error("Nodes should not include s1 itself ", s1.nodes)
+	}
+	// stale rumors are not refuted again
+	s1.onUpdatePkts([]*gen.DisseminationUpdateMsg{newUpdate(s1.name, s2.name, gen.NodeState_DEAD, 0)})
+	if s1.self.Incarnation != 1 {
+		t.Error("Unexpected refutation ", s1.self)
+	}
+}
@@ -0,0 +1,43 @@
+package swim
+
+import (
+	"net"
+	"time"
+)
+
+// Transport connects the node to others: packets carry the protocol, streams carry push-pull.
+// It is also the source of time and starts protocol goroutines, so the protocol can run
+// on a simulated network in simulated time (see Network).
+type Transport interface {
+	// ListenPacket binds the packet endpoint, port 0 picks a free port
+	ListenPacket(addr *net.UDPAddr) (net.PacketConn, error)
+	// ListenStream accepts push-pull connections on the address of the bound packet endpoint
+	ListenStream(addr *net.UDPAddr) (net.Listener, error)
+	// DialStream connects to the remote node from the IP of local
+	DialStream(local, remote *net.UDPAddr, timeout time.Duration) (net.Conn, error)
+
+	Now() time.Time
+	Sleep(d time.Duration)
+	// Go runs f in a new goroutine
+	Go(f func())
+}
+
+// UDPTransport is the real network: UDP packets, TCP streams and wall clock
+type UDPTransport struct{}
+
+func (UDPTransport) ListenPacket(addr *net.UDPAddr) (net.PacketConn, error) {
+	return net.ListenUDP(addr.Network(), addr)
+}
+
+func (UDPTransport) ListenStream(addr *net.UDPAddr) (net.Listener, error) {
+	return net.ListenTCP("tcp", &net.TCPAddr{IP: addr.IP, Port: addr.Port})
+}
+
+func (UDPTransport) DialStream(local, remote *net.UDPAddr, timeout time.Duration) (net.Conn, error) {
+	d := net.Dialer{Timeout: timeout, LocalAddr: &net.TCPAddr{IP: local.IP}}
+	return d.Dial("tcp", remote.String())
+}
+
+func (UDPTransport) Now() time.Time        { return time.Now() }
+func (UDPTransport) Sleep(d time.Duration) { time.Sleep(d) }
+func (UDPTransport) Go(f func())           { go f() }